@@ -16,10 +16,7 @@ func main() {
 
 	// Version Check
 	a.VersionCheck(func() {
-		_ = a.ClearCacheFile(
-			algo.RewardsCacheFile,
-			algo.TransactionCacheFile,
-		)
+		_ = algo.ClearCache()
 	})
 
 	// Window
@@ -35,7 +32,7 @@ func main() {
 	w.SetCloseIntercept(func() {
 		w.Hide()
 	})
-	w.SetContent(ui.RenderLayout())
+	w.SetContent(ui.RenderLayout(w))
 
 	// Initial View
 	if a.Address() == "" {
@@ -56,6 +53,9 @@ func main() {
 	var themeVariant = a.Settings().ThemeVariant()
 	go ui.WatchForThemeVariantChanges(a, w, &themeVariant)
 
+	// Watch for suspension risk, stake eligibility, missed heartbeats and participation key expiry.
+	go ui.WatchAccountAlerts(a)
+
 	// Run
 	w.ShowAndRun()
 }
@@ -0,0 +1,125 @@
+package algo
+
+import "time"
+
+const (
+	// absentFactor is how many expected proposal intervals may pass before an account is absent.
+	absentFactor = 20
+
+	// AbsentWarning is the share of the allowed rounds after which an account is at risk.
+	AbsentWarning = 0.5
+
+	// AbsentCritical is the share of the allowed rounds after which suspension is imminent.
+	AbsentCritical = 0.8
+)
+
+// Absenteeism represents how close an online account is to being suspended as absent.
+//
+// The protocol suspends an account once more than absentFactor times its
+// expected proposal interval has passed since it was last seen, either by
+// proposing, heartbeating or registering its participation key.
+type Absenteeism struct {
+	Round            int64
+	LastSeen         int64
+	ExpectedInterval float64 // Rounds between proposals for the stake share
+	AllowedRounds    int64
+	RoundTime        time.Duration
+}
+
+// NewAbsenteeism creates a new Absenteeism from the account and its expected proposal rate.
+//
+// Returns nil if the account is not online or has no stake.
+func NewAbsenteeism(account *Account, luck *Luck) *Absenteeism {
+	if account == nil || luck == nil || !account.Online() || luck.StakeShare <= 0 {
+		return nil
+	}
+
+	absent := Absenteeism{
+		Round:            luck.Round,
+		LastSeen:         max(account.LastProposed, account.LastHeartbeat),
+		ExpectedInterval: 1 / luck.StakeShare,
+		RoundTime:        luck.RoundTime,
+	}
+	if account.Participation != nil {
+		absent.LastSeen = max(absent.LastSeen, account.Participation.VoteFirstValid)
+	}
+	absent.AllowedRounds = int64(absentFactor * absent.ExpectedInterval)
+
+	return &absent
+}
+
+// FetchAbsenteeism fetches the online stake and round time and returns the absenteeism of the account.
+func FetchAbsenteeism(account *Account) *Absenteeism {
+	return NewAbsenteeism(account, FetchLuck(account))
+}
+
+// RoundsSinceSeen returns the rounds since the account was last seen.
+func (a *Absenteeism) RoundsSinceSeen() int64 {
+	return max(a.Round-a.LastSeen, 0)
+}
+
+// RoundsLeft returns the rounds left before the account is absent.
+func (a *Absenteeism) RoundsLeft() int64 {
+	return max(a.AllowedRounds-a.RoundsSinceSeen(), 0)
+}
+
+// TimeLeft returns the estimated time left before the account is absent.
+func (a *Absenteeism) TimeLeft() time.Duration {
+	return time.Duration(a.RoundsLeft()) * a.RoundTime
+}
+
+// ExpectedTime returns the expected time between proposals.
+func (a *Absenteeism) ExpectedTime() time.Duration {
+	return time.Duration(a.ExpectedInterval * float64(a.RoundTime))
+}
+
+// Progress returns the share of the allowed rounds that have passed since the account was last seen.
+func (a *Absenteeism) Progress() float64 {
+	if a.AllowedRounds <= 0 {
+		return 0
+	}
+	return min(float64(a.RoundsSinceSeen())/float64(a.AllowedRounds), 1)
+}
+
+// Absent returns true if the account has been gone longer than allowed.
+func (a *Absenteeism) Absent() bool {
+	return a.RoundsSinceSeen() > a.AllowedRounds
+}
+
+// AtRisk returns true if the account is past the warning share of the allowed rounds.
+func (a *Absenteeism) AtRisk() bool {
+	return a.Progress() >= AbsentWarning
+}
+
+// Critical returns true if the account is past the critical share of the allowed rounds.
+func (a *Absenteeism) Critical() bool {
+	return a.Progress() >= AbsentCritical
+}
+
+// TimeToNextAlert returns the estimated time until the account passes the next
+// of the warning, critical and absent shares of the allowed rounds, or zero
+// once it is absent.
+func (a *Absenteeism) TimeToNextAlert() time.Duration {
+	for _, share := range []float64{AbsentWarning, AbsentCritical, 1} {
+		// The time left runs out at the end of the allowed rounds
+		after := a.AllowedRounds - int64(share*float64(a.AllowedRounds))
+		until := a.TimeLeft() - time.Duration(after)*a.RoundTime
+		if until > 0 {
+			return until
+		}
+	}
+	return 0
+}
+
+// NextCheck returns the interval, or the time until the next alert if that is sooner.
+//
+// Large stakes have an absenteeism window shorter than the usual interval, so
+// the account is checked again as soon as it can pass the next share. The
+// check waits at least one round.
+func (a *Absenteeism) NextCheck(interval time.Duration) time.Duration {
+	until := a.TimeToNextAlert()
+	if until <= 0 {
+		return interval
+	}
+	return min(interval, max(until, a.RoundTime))
+}
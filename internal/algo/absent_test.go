@@ -0,0 +1,71 @@
+package algo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAbsenteeismNextCheck(t *testing.T) {
+	roundTime := 2800 * time.Millisecond
+	// absentAfter returns the absenteeism of an account with the stake share last seen the given rounds ago.
+	absentAfter := func(share float64, rounds int64) *Absenteeism {
+		account := &Account{Status: "Online", LastProposed: 100_000 - rounds}
+		return NewAbsenteeism(account, &Luck{Round: 100_000, StakeShare: share, RoundTime: roundTime})
+	}
+
+	tests := []struct {
+		name   string
+		absent *Absenteeism
+		want   time.Duration
+	}{
+		// 1,000 allowed rounds, a window of 46m40s
+		{"large stake", absentAfter(0.02, 0), 500 * roundTime},
+		{"large stake at risk", absentAfter(0.02, 600), 200 * roundTime},
+		{"large stake critical", absentAfter(0.02, 900), 100 * roundTime},
+		{"large stake absent", absentAfter(0.02, 1200), time.Hour},
+		{"large stake just short of a share", absentAfter(0.02, 499), roundTime},
+		// 100,000 allowed rounds, a window of more than three days
+		{"small stake", absentAfter(0.0002, 0), time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.absent.NextCheck(time.Hour); got != tt.want {
+				t.Errorf("NextCheck() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAbsenteeismRecheckedBeforeAbsent(t *testing.T) {
+	luck := &Luck{Round: 100_000, StakeShare: 0.02, RoundTime: 2800 * time.Millisecond}
+	account := &Account{Status: "Online", LastProposed: 100_000}
+	absent := NewAbsenteeism(account, luck)
+	if window := time.Duration(absent.AllowedRounds) * absent.RoundTime; window >= time.Hour {
+		t.Fatalf("window = %s, want less than an hour", window)
+	}
+
+	// Follow the checks until the account is absent, keeping each level once
+	var alerts []string
+	alert := func(level string) {
+		if len(alerts) == 0 || alerts[len(alerts)-1] != level {
+			alerts = append(alerts, level)
+		}
+	}
+	for checks := 0; !absent.Absent(); checks++ {
+		if checks > 10 {
+			t.Fatal("too many checks before the account is absent")
+		}
+		elapsed := int64(absent.NextCheck(time.Hour) / absent.RoundTime)
+		absent.Round += elapsed
+		switch {
+		case absent.Absent():
+		case absent.Critical():
+			alert("critical")
+		case absent.AtRisk():
+			alert("warning")
+		}
+	}
+	if len(alerts) != 2 || alerts[0] != "warning" || alerts[1] != "critical" {
+		t.Errorf("alerts = %v, want a warning and a critical alert before the account is absent", alerts)
+	}
+}
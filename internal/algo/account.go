@@ -6,12 +6,29 @@ import (
 
 // Account represents stats of an account.
 type Account struct {
-	Address           string `json:"-"`
-	Amount            int64  `json:"amount"`
-	Status            string `json:"status"`
-	IncentiveEligible bool   `json:"incentive-eligible"`
-	LastHeartbeat     int64  `json:"last-heartbeat"`
-	LastProposed      int64  `json:"last-proposed"`
+	Address                     string         `json:"-"`
+	Amount                      int64          `json:"amount"`
+	AmountWithoutPendingRewards int64          `json:"amount-without-pending-rewards"`
+	MinBalance                  int64          `json:"min-balance"`
+	PendingRewards              int64          `json:"pending-rewards"`
+	Rewards                     int64          `json:"rewards"`
+	Round                       int64          `json:"round"`
+	Status                      string         `json:"status"`
+	AuthAddr                    string         `json:"auth-addr"`
+	IncentiveEligible           bool           `json:"incentive-eligible"`
+	LastHeartbeat               int64          `json:"last-heartbeat"`
+	LastProposed                int64          `json:"last-proposed"`
+	Participation               *Participation `json:"participation"`
+}
+
+// Participation represents the participation key registered for an account.
+type Participation struct {
+	SelectionKey    []byte `json:"selection-participation-key"`
+	VoteKey         []byte `json:"vote-participation-key"`
+	StateProofKey   []byte `json:"state-proof-key"`
+	VoteFirstValid  int64  `json:"vote-first-valid"`
+	VoteLastValid   int64  `json:"vote-last-valid"`
+	VoteKeyDilution int64  `json:"vote-key-dilution"`
 }
 
 // AlgoBalance returns the balance in Algos.
@@ -19,6 +36,31 @@ func (a *Account) AlgoBalance() float64 {
 	return float64(a.Amount) / 1e6
 }
 
+// AlgoMinBalance returns the minimum balance in Algos.
+func (a *Account) AlgoMinBalance() float64 {
+	return float64(a.MinBalance) / 1e6
+}
+
+// AlgoPendingRewards returns the pending rewards in Algos.
+func (a *Account) AlgoPendingRewards() float64 {
+	return float64(a.PendingRewards) / 1e6
+}
+
+// AlgoRewards returns the total participation rewards in Algos.
+func (a *Account) AlgoRewards() float64 {
+	return float64(a.Rewards) / 1e6
+}
+
+// Online returns true if the account is registered online.
+func (a *Account) Online() bool {
+	return a.Status == "Online"
+}
+
+// Rekeyed returns true if the account is rekeyed to another address.
+func (a *Account) Rekeyed() bool {
+	return a.AuthAddr != ""
+}
+
 // FetchAccount fetches account stats from the nodely api.
 //
 // Docs: https://nodely.io/swagger/index.html?url=/swagger/api/4160/algod.oas3.yml#/public/AccountInformation
@@ -0,0 +1,243 @@
+package algo
+
+import (
+	"sort"
+	"time"
+)
+
+// BalanceChange represents a change of the account balance in microalgos.
+type BalanceChange struct {
+	Time  time.Time
+	Round int64
+	Delta int64
+}
+
+// BalanceHistory represents the balance of an account over time.
+type BalanceHistory struct {
+	Start   int64 // Balance before the first change
+	Current int64
+	Changes []BalanceChange // Oldest first
+}
+
+// NewBalanceHistory reconstructs the balance history from the current balance.
+//
+// Walking back from the current balance, proposer payouts, received payments
+// and received close amounts are taken off and sent payments, close amounts
+// and fees are added back. Asset and application effects on the ALGO balance
+// are not tracked.
+func NewBalanceHistory(account *Account, blocks []BlockHeader, txs []TransactionDetail) *BalanceHistory {
+	history := BalanceHistory{Current: account.Amount}
+
+	for _, block := range blocks {
+		if block.ProposerPayout > 0 {
+			history.Changes = append(history.Changes, BalanceChange{Time: block.Time(), Round: block.Round, Delta: block.ProposerPayout})
+		}
+	}
+	for _, tx := range txs {
+		var delta int64
+		if tx.Sender == account.Address {
+			delta -= tx.Fee
+			if tx.Payment != nil {
+				delta -= tx.Payment.Amount + tx.Payment.CloseAmount
+			}
+		}
+		if tx.Payment != nil && tx.Payment.Receiver == account.Address {
+			delta += tx.Payment.Amount
+		}
+		if tx.Payment != nil && tx.Payment.CloseRemainderTo == account.Address {
+			delta += tx.Payment.CloseAmount
+		}
+		if delta != 0 {
+			history.Changes = append(history.Changes, BalanceChange{Time: tx.Time(), Round: tx.ConfirmedRound, Delta: delta})
+		}
+	}
+	sort.SliceStable(history.Changes, func(i, j int) bool {
+		return history.Changes[i].Round < history.Changes[j].Round
+	})
+
+	history.Start = history.Current
+	for _, change := range history.Changes {
+		history.Start -= change.Delta
+	}
+
+	return &history
+}
+
+// FetchBalanceHistory returns the balance history of the account.
+func FetchBalanceHistory(account *Account, blocks []BlockHeader) *BalanceHistory {
+	if account == nil {
+		return nil
+	}
+	transactions := FetchTransactions(account.Address)
+	if transactions == nil {
+		return nil
+	}
+	return NewBalanceHistory(account, blocks, transactions.Transactions)
+}
+
+// At returns the balance in microalgos at the given time.
+func (h *BalanceHistory) At(t time.Time) int64 {
+	balance := h.Start
+	for _, change := range h.Changes {
+		if change.Time.After(t) {
+			break
+		}
+		balance += change.Delta
+	}
+	return max(balance, 0)
+}
+
+// DailyBalance represents the balance of an account on a day in microalgos.
+type DailyBalance struct {
+	Date    string // YYYY-MM-DD
+	Balance int64  // At the end of the day
+	Min     int64  // Lowest during the day
+	Max     int64  // Highest during the day
+}
+
+// AlgoBalance returns the balance at the end of the day in Algos.
+func (d *DailyBalance) AlgoBalance() float64 {
+	return float64(d.Balance) / 1e6
+}
+
+// AlgoMin returns the lowest balance during the day in Algos.
+func (d *DailyBalance) AlgoMin() float64 {
+	return float64(d.Min) / 1e6
+}
+
+// AlgoMax returns the highest balance during the day in Algos.
+func (d *DailyBalance) AlgoMax() float64 {
+	return float64(d.Max) / 1e6
+}
+
+// Daily returns the balance of each day from start to end (YYYY-MM-DD) in the local timezone, oldest first.
+func (h *BalanceHistory) Daily(start, end string) []DailyBalance {
+	from, err := time.ParseInLocation("2006-01-02", start, time.Local)
+	if err != nil {
+		return nil
+	}
+	to, err := time.ParseInLocation("2006-01-02", end, time.Local)
+	if err != nil {
+		return nil
+	}
+
+	var days []DailyBalance
+	next := 0 // First change not yet applied
+	balance := h.Start
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		dayEnd := day.AddDate(0, 0, 1)
+
+		// Apply the changes before the day
+		for next < len(h.Changes) && h.Changes[next].Time.Before(day) {
+			balance += h.Changes[next].Delta
+			next++
+		}
+
+		// Track the lowest and highest balance during the day
+		daily := DailyBalance{Date: day.Format("2006-01-02"), Min: max(balance, 0), Max: max(balance, 0)}
+		for next < len(h.Changes) && h.Changes[next].Time.Before(dayEnd) {
+			balance += h.Changes[next].Delta
+			daily.Min = min(daily.Min, max(balance, 0))
+			daily.Max = max(daily.Max, max(balance, 0))
+			next++
+		}
+		daily.Balance = max(balance, 0)
+
+		days = append(days, daily)
+	}
+
+	return days
+}
+
+// AlgoAverage returns the time-weighted average balance in Algos between start and end.
+func (h *BalanceHistory) AlgoAverage(start, end time.Time) float64 {
+	if !end.After(start) {
+		return 0
+	}
+
+	balance := h.At(start)
+	cursor := start
+	var area float64
+	for _, change := range h.Changes {
+		if !change.Time.After(start) {
+			continue
+		}
+		if !change.Time.Before(end) {
+			break
+		}
+		area += float64(balance) * change.Time.Sub(cursor).Seconds()
+		balance = max(balance+change.Delta, 0)
+		cursor = change.Time
+	}
+	area += float64(balance) * end.Sub(cursor).Seconds()
+
+	return area / end.Sub(start).Seconds() / 1e6
+}
+
+// SetStake sets the time-weighted stake of the daily payouts and regroups the payouts.
+//
+// Today only counts the part of the day that has passed.
+func (r *Rewards) SetStake(h *BalanceHistory) {
+	if h == nil {
+		return
+	}
+
+	now := time.Now()
+	for i, payout := range r.Daily {
+		start, err := time.ParseInLocation("2006-01-02", payout.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		end := start.AddDate(0, 0, 1)
+		if end.After(now) {
+			end = now
+		}
+		r.Daily[i].StakeDays = h.AlgoAverage(start, end) * payout.Days
+	}
+
+	// Regroup the payouts with the stake of each day
+	rewards := NewRewards(r.Daily)
+	rewards.Blocks = r.Blocks
+	rewards.Currency = r.Currency
+	rewards.Luck = r.Luck
+	rewards.Balances = h
+	*r = *rewards
+}
+
+// AverageStake returns the time-weighted average stake in Algos of the payouts.
+func (pd *PayoutDate) AverageStake() float64 {
+	if pd.Days <= 0 {
+		return 0
+	}
+	return pd.StakeDays / pd.Days
+}
+
+// RewardsPerThousand returns the rewards per 1,000 ALGO staked.
+func (pd *PayoutDate) RewardsPerThousand() float64 {
+	stake := pd.AverageStake()
+	if stake <= 0 {
+		return 0
+	}
+	return pd.AlgoPayout() / stake * 1000
+}
+
+// WinsPerMillion returns the wins per 1,000,000 ALGO staked.
+func (pd *PayoutDate) WinsPerMillion() float64 {
+	stake := pd.AverageStake()
+	if stake <= 0 {
+		return 0
+	}
+	return float64(pd.TotalWins) / stake * 1e6
+}
+
+// StakeTotals returns the payouts in range combined into one for stake-normalised stats.
+func (r *Rewards) StakeTotals() PayoutDate {
+	var total PayoutDate
+	for _, payout := range r.Payouts {
+		total.Payout += payout.Payout
+		total.TotalWins += payout.TotalWins
+		total.Days += payout.Days
+		total.StakeDays += payout.StakeDays
+	}
+	return total
+}
@@ -0,0 +1,256 @@
+package algo
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+)
+
+func TestBalanceHistoryAlgoAverage(t *testing.T) {
+	start := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	hours := func(n int) time.Time {
+		return start.Add(time.Duration(n) * time.Hour)
+	}
+	history := &BalanceHistory{
+		Start:   1000e6,
+		Current: 1500e6,
+		Changes: []BalanceChange{
+			{Time: hours(6), Round: 1, Delta: 1000e6},
+			{Time: hours(18), Round: 2, Delta: -500e6},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       float64
+	}{
+		{"whole day", hours(0), hours(24), (1000*6 + 2000*12 + 1500*6) / 24.0},
+		{"before the changes", hours(0), hours(6), 1000},
+		{"starts at a change", hours(6), hours(18), 2000},
+		{"ends at a change", hours(12), hours(18), 2000},
+		{"after the changes", hours(20), hours(30), 1500},
+		{"empty", hours(12), hours(12), 0},
+		{"reversed", hours(12), hours(6), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := history.AlgoAverage(tt.start, tt.end); !approx(got, tt.want) {
+				t.Errorf("AlgoAverage() = %g, want %g", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayoutDateStakeMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		payout      PayoutDate
+		stake       float64
+		perThousand float64
+		perMillion  float64
+	}{
+		{"no days", PayoutDate{Payout: 10e6, TotalWins: 2, StakeDays: 100_000}, 0, 0, 0},
+		{"no stake", PayoutDate{Payout: 10e6, TotalWins: 2, Days: 2}, 0, 0, 0},
+		{"one day", PayoutDate{Payout: 10e6, TotalWins: 1, Days: 1, StakeDays: 100_000}, 100_000, 0.1, 10},
+		{"two days", PayoutDate{Payout: 10e6, TotalWins: 2, Days: 2, StakeDays: 100_000}, 50_000, 0.2, 40},
+		{"part of a day", PayoutDate{Payout: 5e6, TotalWins: 1, Days: 0.5, StakeDays: 25_000}, 50_000, 0.1, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.payout.AverageStake(); !approx(got, tt.stake) {
+				t.Errorf("AverageStake() = %g, want %g", got, tt.stake)
+			}
+			if got := tt.payout.RewardsPerThousand(); !approx(got, tt.perThousand) {
+				t.Errorf("RewardsPerThousand() = %g, want %g", got, tt.perThousand)
+			}
+			if got := tt.payout.WinsPerMillion(); !approx(got, tt.perMillion) {
+				t.Errorf("WinsPerMillion() = %g, want %g", got, tt.perMillion)
+			}
+		})
+	}
+}
+
+func TestRewardsSetStake(t *testing.T) {
+	test.NewTempApp(t)
+
+	today := time.Now()
+	day := func(offset int) time.Time {
+		return time.Date(today.Year(), today.Month(), today.Day()+offset, 0, 0, 0, 0, time.Local)
+	}
+	// The balance doubles halfway through yesterday
+	history := &BalanceHistory{
+		Start:   1000e6,
+		Current: 2000e6,
+		Changes: []BalanceChange{{Time: day(-1).Add(day(0).Sub(day(-1)) / 2), Round: 1, Delta: 1000e6}},
+	}
+	var daily []PayoutDate
+	for offset := -2; offset <= 0; offset++ {
+		daily = append(daily, PayoutDate{Date: day(offset).Format("2006-01-02"), Payout: 10e6, TotalWins: 1, Days: 1})
+	}
+
+	rewards := NewRewards(daily)
+	rewards.SetStake(nil)
+	if total := rewards.StakeTotals(); total.StakeDays != 0 {
+		t.Fatalf("StakeDays = %g without a balance history, want 0", total.StakeDays)
+	}
+
+	rewards.SetStake(history)
+	if rewards.Balances != history {
+		t.Error("Balances is not the balance history")
+	}
+	stakes := map[string]float64{
+		day(-2).Format("2006-01-02"): 1000,
+		day(-1).Format("2006-01-02"): 1500,
+		day(0).Format("2006-01-02"):  2000, // So far today
+	}
+	for _, payout := range rewards.Daily {
+		if !approx(payout.AverageStake(), stakes[payout.Date]) {
+			t.Errorf("%s AverageStake() = %g, want %g", payout.Date, payout.AverageStake(), stakes[payout.Date])
+		}
+	}
+
+	total := rewards.StakeTotals()
+	if total.Payout != 30e6 || total.TotalWins != 3 || total.Days != 3 {
+		t.Errorf("StakeTotals() = %+v, want 30 Algo and 3 wins over 3 days", total)
+	}
+	if !approx(total.AverageStake(), 1500) {
+		t.Errorf("AverageStake() = %g, want 1500", total.AverageStake())
+	}
+	if !approx(total.RewardsPerThousand(), 20) || !approx(total.WinsPerMillion(), 2000) {
+		t.Errorf("RewardsPerThousand() = %g and WinsPerMillion() = %g, want 20 and 2000", total.RewardsPerThousand(), total.WinsPerMillion())
+	}
+}
+
+func TestNewBalanceHistory(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	at := func(round int64) int64 {
+		return start.Add(time.Duration(round) * time.Hour).Unix()
+	}
+	pay := func(round int64, sender, receiver string, amount, fee int64) TransactionDetail {
+		return TransactionDetail{
+			Sender:         sender,
+			Fee:            fee,
+			ConfirmedRound: round,
+			Timestamp:      at(round),
+			Payment:        &PaymentTransaction{Amount: amount, Receiver: receiver},
+		}
+	}
+
+	closeTo := pay(7, "D", "E", 1e6, 1000)
+	closeTo.Payment.CloseRemainderTo, closeTo.Payment.CloseAmount = "A", 30e6
+	closeFrom := pay(6, "A", "B", 0, 1000)
+	closeFrom.Payment.CloseRemainderTo, closeFrom.Payment.CloseAmount = "C", 20e6
+	keyreg := TransactionDetail{Sender: "A", Fee: 2000, ConfirmedRound: 4, Timestamp: at(4), KeyReg: &KeyRegTransaction{}}
+
+	blocks := []BlockHeader{
+		{Round: 2, Timestamp: at(2), ProposerPayout: 10e6},
+		{Round: 9, Timestamp: at(9)}, // No payout
+	}
+	txs := []TransactionDetail{
+		closeTo,
+		pay(3, "A", "B", 50e6, 1000),
+		pay(1, "B", "A", 100e6, 1000), // The sender pays the fee
+		keyreg,
+		pay(5, "A", "A", 5e6, 1000), // Only the fee leaves the account
+		closeFrom,
+		pay(8, "B", "C", 1e6, 1000), // Not the account
+	}
+
+	history := NewBalanceHistory(&Account{Address: "A", Amount: 1000e6}, blocks, txs)
+
+	want := []BalanceChange{
+		{Round: 1, Delta: 100e6},
+		{Round: 2, Delta: 10e6},
+		{Round: 3, Delta: -50_001_000},
+		{Round: 4, Delta: -2000},
+		{Round: 5, Delta: -1000},
+		{Round: 6, Delta: -20_001_000},
+		{Round: 7, Delta: 30e6},
+	}
+	if len(history.Changes) != len(want) {
+		t.Fatalf("Changes = %+v, want %d changes", history.Changes, len(want))
+	}
+	for i, change := range history.Changes {
+		if change.Round != want[i].Round || change.Delta != want[i].Delta || change.Time.Unix() != at(want[i].Round) {
+			t.Errorf("change %d = %+v, want %+v at round %d", i, change, want[i].Delta, want[i].Round)
+		}
+	}
+	if history.Current != 1000e6 || history.Start != 930_005_000 {
+		t.Errorf("Start = %d and Current = %d, want 930005000 and 1000000000", history.Start, history.Current)
+	}
+
+	tests := []struct {
+		round int64
+		want  int64
+	}{
+		{0, 930_005_000},
+		{1, 1_030_005_000}, // Changes apply from their own time
+		{3, 990_004_000},
+		{7, 1000e6},
+		{100, 1000e6},
+	}
+	for _, tt := range tests {
+		if got := history.At(time.Unix(at(tt.round), 0)); got != tt.want {
+			t.Errorf("At(round %d) = %d, want %d", tt.round, got, tt.want)
+		}
+	}
+}
+
+func TestBalanceHistoryNeverNegative(t *testing.T) {
+	// Untracked asset and application effects can take the rebuilt balance below zero
+	payout := time.Date(2026, 10, 1, 12, 0, 0, 0, time.Local)
+	history := NewBalanceHistory(&Account{Address: "A", Amount: 5e6}, []BlockHeader{blockAt(1, payout)}, nil)
+	if history.Start != -5e6 {
+		t.Fatalf("Start = %d, want -5000000", history.Start)
+	}
+	if got := history.At(payout.Add(-time.Hour)); got != 0 {
+		t.Errorf("At() = %d, want 0", got)
+	}
+	if days := history.Daily("2026-10-01", "2026-10-01"); len(days) != 1 || days[0].Min != 0 || days[0].Max != 5e6 {
+		t.Errorf("Daily() = %+v, want a balance from 0 to 5000000", days)
+	}
+}
+
+func TestBalanceHistoryDaily(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2026, 10, day, hour, 0, 0, 0, time.Local)
+	}
+	history := &BalanceHistory{
+		Start:   100,
+		Current: 100,
+		Changes: []BalanceChange{
+			{Time: at(2, 10), Round: 1, Delta: 100},
+			{Time: at(2, 14), Round: 2, Delta: -150},
+			{Time: at(4, 9), Round: 3, Delta: 50},
+		},
+	}
+
+	want := []DailyBalance{
+		{Date: "2026-10-01", Balance: 100, Min: 100, Max: 100},
+		{Date: "2026-10-02", Balance: 50, Min: 50, Max: 200},
+		{Date: "2026-10-03", Balance: 50, Min: 50, Max: 50},
+		{Date: "2026-10-04", Balance: 100, Min: 50, Max: 100},
+	}
+	days := history.Daily("2026-10-01", "2026-10-04")
+	if len(days) != len(want) {
+		t.Fatalf("Daily() = %+v, want %d days", days, len(want))
+	}
+	for i := range want {
+		if days[i] != want[i] {
+			t.Errorf("day %d = %+v, want %+v", i, days[i], want[i])
+		}
+	}
+
+	// Ranges start from the balance of the changes before them
+	if days := history.Daily("2026-10-03", "2026-10-03"); len(days) != 1 || days[0].Balance != 50 {
+		t.Errorf("Daily() = %+v, want a balance of 50", days)
+	}
+	if days := history.Daily("2026-10-10", "2026-10-11"); len(days) != 2 || days[1].Balance != 100 {
+		t.Errorf("Daily() = %+v, want a balance of 100", days)
+	}
+	if days := history.Daily("not a date", "2026-10-04"); days != nil {
+		t.Errorf("Daily() = %+v, want nil for an invalid date", days)
+	}
+}
@@ -0,0 +1,48 @@
+package algo
+
+import "math"
+
+// BonusPlan represents the protocol's proposer bonus schedule.
+type BonusPlan struct {
+	BaseRound     int64
+	BaseAmount    int64
+	DecayInterval int64
+	DecayPercent  float64
+}
+
+// MainnetBonusPlan is the proposer bonus schedule of mainnet.
+//
+// Docs: https://github.com/algorand/go-algorand/blob/master/config/consensus.go
+var MainnetBonusPlan = BonusPlan{
+	BaseRound:     46_512_890,
+	BaseAmount:    10_000_000,
+	DecayInterval: 1_000_000,
+	DecayPercent:  1,
+}
+
+// BonusAt returns the scheduled bonus in microalgos for the given round.
+func (p BonusPlan) BonusAt(round int64) int64 {
+	if round < p.BaseRound {
+		return 0
+	}
+	if p.DecayInterval == 0 {
+		return p.BaseAmount
+	}
+
+	// The bonus decays every time the round is a multiple of the decay interval.
+	decays := round/p.DecayInterval - p.BaseRound/p.DecayInterval
+	return int64(float64(p.BaseAmount) * math.Pow(1-p.DecayPercent/100, float64(decays)))
+}
+
+// AlgoBonusAt returns the scheduled bonus in Algos for the given round.
+func (p BonusPlan) AlgoBonusAt(round int64) float64 {
+	return float64(p.BonusAt(round)) / 1e6
+}
+
+// RoundsToNextDecay returns the number of rounds from the given round until the bonus next decays.
+func (p BonusPlan) RoundsToNextDecay(round int64) int64 {
+	if p.DecayInterval == 0 {
+		return 0
+	}
+	return p.DecayInterval - round%p.DecayInterval
+}
@@ -0,0 +1,90 @@
+package algo
+
+import (
+	"sort"
+)
+
+// BreakdownRow represents the share of a period's rewards from the bonus and from fees.
+type BreakdownRow struct {
+	Date       string
+	Bonus      float64
+	Fees       float64
+	BonusShare float64
+	FeeShare   float64
+}
+
+// BonusPoint represents the average bonus per block of a day and the scheduled bonus.
+type BonusPoint struct {
+	Date      string
+	Bonus     float64
+	Scheduled float64
+}
+
+// Breakdown represents how much of the rewards come from the protocol bonus and from fees.
+type Breakdown struct {
+	Rows       []BreakdownRow
+	Bonus      float64
+	Fees       float64
+	BonusShare float64
+	FeeShare   float64
+	Points     []BonusPoint
+}
+
+// NewBreakdown creates a new Breakdown for the payouts of the current view.
+//
+// The bonus per block is averaged per day and compared with the bonus the
+// given plan schedules for the rounds of that day.
+func NewBreakdown(r *Rewards, plan BonusPlan) *Breakdown {
+	var breakdown Breakdown
+
+	for _, payout := range r.Payouts {
+		row := newBreakdownRow(payout.Date, payout.AlgoBonus(), payout.AlgoFeesCollected())
+		breakdown.Rows = append(breakdown.Rows, row)
+		breakdown.Bonus += row.Bonus
+		breakdown.Fees += row.Fees
+	}
+	total := newBreakdownRow("", breakdown.Bonus, breakdown.Fees)
+	breakdown.BonusShare = total.BonusShare
+	breakdown.FeeShare = total.FeeShare
+
+	// Average the bonus per block by day
+	type day struct {
+		bonus, scheduled float64
+		blocks           int64
+	}
+	days := make(map[string]*day)
+	for _, block := range r.Blocks {
+		date := block.Time().Format("2006-01-02")
+		if !r.InRange(date) {
+			continue
+		}
+		if _, ok := days[date]; !ok {
+			days[date] = &day{}
+		}
+		days[date].bonus += block.AlgoBonus()
+		days[date].scheduled += plan.AlgoBonusAt(block.Round)
+		days[date].blocks++
+	}
+	for date, d := range days {
+		breakdown.Points = append(breakdown.Points, BonusPoint{
+			Date:      date,
+			Bonus:     d.bonus / float64(d.blocks),
+			Scheduled: d.scheduled / float64(d.blocks),
+		})
+	}
+	sort.Slice(breakdown.Points, func(i, j int) bool {
+		return breakdown.Points[i].Date < breakdown.Points[j].Date
+	})
+
+	return &breakdown
+}
+
+// newBreakdownRow returns the bonus and fee shares of the given amounts.
+func newBreakdownRow(date string, bonus, fees float64) BreakdownRow {
+	row := BreakdownRow{Date: date, Bonus: bonus, Fees: fees}
+	if total := bonus + fees; total > 0 {
+		row.BonusShare = bonus / total
+		row.FeeShare = fees / total
+	}
+	return row
+}
@@ -0,0 +1,49 @@
+package algo
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+var (
+	// rewardsCacheMu guards the read, fetch and write of the rewards cache file.
+	rewardsCacheMu sync.Mutex
+
+	// transactionCacheMu guards the read, fetch and write of the transaction cache file.
+	transactionCacheMu sync.Mutex
+)
+
+// writeCacheFile writes the value as JSON to a temporary file and renames it
+// over the cache file, so readers never see a partially written cache.
+func writeCacheFile(path string, v any) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	encoder := json.NewEncoder(file)
+	if err := encoder.Encode(v); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// ClearCache removes the rewards and transaction cache files once no fetch is using them.
+func ClearCache() error {
+	rewardsCacheMu.Lock()
+	defer rewardsCacheMu.Unlock()
+	transactionCacheMu.Lock()
+	defer transactionCacheMu.Unlock()
+
+	return app.CurrentApp().ClearCacheFile(RewardsCacheFile, TransactionCacheFile)
+}
@@ -0,0 +1,88 @@
+package algo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+// Calendar represents the first day of the week and the first month of the fiscal year.
+type Calendar struct {
+	WeekStart       time.Weekday
+	FiscalYearStart time.Month
+}
+
+// CurrentCalendar returns the calendar selected in the app.
+func CurrentCalendar() Calendar {
+	return Calendar{
+		WeekStart:       time.Weekday(app.CurrentApp().WeekStart()),
+		FiscalYearStart: time.Month(app.CurrentApp().FiscalYearStart()),
+	}
+}
+
+// Weekdays returns the days of the week starting with the first day of the week.
+func (c Calendar) Weekdays() []time.Weekday {
+	days := make([]time.Weekday, 7)
+	for i := range days {
+		days[i] = (c.WeekStart + time.Weekday(i)) % 7
+	}
+	return days
+}
+
+// WeekStartOf returns the first day of the week the time belongs to.
+func (c Calendar) WeekStartOf(t time.Time) time.Time {
+	offset := (int(t.Weekday()) - int(c.WeekStart) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// Week returns the key of the week the time belongs to, such as "2024-W05".
+//
+// Weeks starting on Monday are ISO weeks. Weeks starting on another day take
+// the number of the ISO week holding their fourth day, so every week has a
+// unique key that sorts in order.
+func (c Calendar) Week(t time.Time) string {
+	year, week := c.WeekStartOf(t).AddDate(0, 0, 3).ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// FiscalYear returns the fiscal year the time belongs to.
+//
+// Fiscal years not starting in January are named after the calendar year
+// they end in, so a fiscal year starting in July 2024 is fiscal year 2025.
+func (c Calendar) FiscalYear(t time.Time) int {
+	if c.FiscalYearStart <= time.January || t.Month() < c.FiscalYearStart {
+		return t.Year()
+	}
+	return t.Year() + 1
+}
+
+// FiscalYearStartOf returns the first day of the fiscal year the time belongs to.
+func (c Calendar) FiscalYearStartOf(t time.Time) time.Time {
+	start := time.Date(t.Year(), max(c.FiscalYearStart, time.January), 1, 0, 0, 0, 0, t.Location())
+	if start.After(t) {
+		start = start.AddDate(-1, 0, 0)
+	}
+	return start
+}
+
+// Year returns the key of the year the time belongs to, such as "2024" or "FY2025".
+func (c Calendar) Year(t time.Time) string {
+	if c.FiscalYearStart <= time.January {
+		return t.Format("2006")
+	}
+	return fmt.Sprintf("FY%d", c.FiscalYear(t))
+}
+
+// QuarterStartOf returns the first day of the quarter of the fiscal year the time belongs to.
+func (c Calendar) QuarterStartOf(t time.Time) time.Time {
+	start := c.FiscalYearStartOf(t)
+	months := (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	return start.AddDate(0, months/3*3, 0)
+}
+
+// Quarter returns the key of the quarter the time belongs to, such as "2024-Q1" or "FY2025-Q1".
+func (c Calendar) Quarter(t time.Time) string {
+	month := (int(t.Month()) - int(max(c.FiscalYearStart, time.January)) + 12) % 12
+	return fmt.Sprintf("%s-Q%d", c.Year(t), month/3+1)
+}
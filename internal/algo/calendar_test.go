@@ -0,0 +1,137 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+// noon returns noon on the given date.
+func noon(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 12, 0, 0, 0, time.Local)
+}
+
+// midnight returns the start of the given date.
+func midnight(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func TestCalendarWeekStartOf(t *testing.T) {
+	tests := []struct {
+		weekStart time.Weekday
+		t         time.Time
+		want      time.Time
+	}{
+		{time.Monday, noon(2026, 10, 19), midnight(2026, 10, 19)},
+		{time.Sunday, noon(2026, 10, 19), midnight(2026, 10, 18)},
+		{time.Saturday, noon(2026, 10, 19), midnight(2026, 10, 17)},
+		{time.Saturday, noon(2026, 10, 17), midnight(2026, 10, 17)},
+		{time.Sunday, noon(2026, 11, 2), midnight(2026, 11, 1)},
+		{time.Wednesday, noon(2026, 1, 2), midnight(2025, 12, 31)},
+	}
+	for _, tt := range tests {
+		c := Calendar{WeekStart: tt.weekStart}
+		if got := c.WeekStartOf(tt.t); !got.Equal(tt.want) {
+			t.Errorf("%s WeekStartOf(%s) = %s, want %s", tt.weekStart, tt.t.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestCalendarWeek(t *testing.T) {
+	tests := []struct {
+		weekStart time.Weekday
+		t         time.Time
+		want      string
+	}{
+		{time.Monday, noon(2024, 1, 31), "2024-W05"},
+		{time.Monday, noon(2024, 12, 29), "2024-W52"},
+		{time.Monday, noon(2024, 12, 30), "2025-W01"},
+		{time.Sunday, noon(2024, 12, 28), "2024-W52"},
+		{time.Sunday, noon(2024, 12, 29), "2025-W01"},
+		{time.Saturday, noon(2024, 12, 27), "2024-W52"},
+		{time.Saturday, noon(2024, 12, 28), "2025-W01"},
+		{time.Saturday, noon(2026, 1, 2), "2026-W01"},
+		{time.Sunday, noon(2027, 1, 2), "2026-W53"},
+	}
+	for _, tt := range tests {
+		c := Calendar{WeekStart: tt.weekStart}
+		if got := c.Week(tt.t); got != tt.want {
+			t.Errorf("%s Week(%s) = %q, want %q", tt.weekStart, tt.t.Format("2006-01-02"), got, tt.want)
+		}
+	}
+}
+
+func TestCalendarWeekKeysSortInOrder(t *testing.T) {
+	for _, weekStart := range []time.Weekday{time.Sunday, time.Monday, time.Saturday} {
+		c := Calendar{WeekStart: weekStart}
+		previous := c.Week(noon(2024, 1, 1))
+		for d := noon(2024, 1, 2); d.Year() < 2028; d = d.AddDate(0, 0, 1) {
+			key := c.Week(d)
+			if key < previous {
+				t.Fatalf("%s Week(%s) = %q, want after %q", weekStart, d.Format("2006-01-02"), key, previous)
+			}
+			// A new key starts on the first day of the week only
+			if (key != previous) != (d.Weekday() == weekStart) {
+				t.Fatalf("%s Week(%s) = %q after %q on a %s", weekStart, d.Format("2006-01-02"), key, previous, d.Weekday())
+			}
+			previous = key
+		}
+	}
+}
+
+func TestCalendarYearAndQuarter(t *testing.T) {
+	tests := []struct {
+		fiscalYearStart time.Month
+		t               time.Time
+		year            string
+		quarter         string
+		yearStart       time.Time
+		quarterStart    time.Time
+	}{
+		{0, noon(2024, 3, 31), "2024", "2024-Q1", midnight(2024, 1, 1), midnight(2024, 1, 1)},
+		{time.January, noon(2024, 4, 1), "2024", "2024-Q2", midnight(2024, 1, 1), midnight(2024, 4, 1)},
+		{time.January, noon(2024, 12, 31), "2024", "2024-Q4", midnight(2024, 1, 1), midnight(2024, 10, 1)},
+		{time.July, noon(2024, 6, 30), "FY2024", "FY2024-Q4", midnight(2023, 7, 1), midnight(2024, 4, 1)},
+		{time.July, noon(2024, 7, 1), "FY2025", "FY2025-Q1", midnight(2024, 7, 1), midnight(2024, 7, 1)},
+		{time.July, noon(2024, 12, 31), "FY2025", "FY2025-Q2", midnight(2024, 7, 1), midnight(2024, 10, 1)},
+		{time.July, noon(2025, 1, 1), "FY2025", "FY2025-Q3", midnight(2024, 7, 1), midnight(2025, 1, 1)},
+		{time.April, noon(2025, 3, 31), "FY2025", "FY2025-Q4", midnight(2024, 4, 1), midnight(2025, 1, 1)},
+		{time.April, noon(2025, 4, 1), "FY2026", "FY2026-Q1", midnight(2025, 4, 1), midnight(2025, 4, 1)},
+		{time.October, noon(2025, 9, 30), "FY2025", "FY2025-Q4", midnight(2024, 10, 1), midnight(2025, 7, 1)},
+		{time.February, noon(2025, 1, 31), "FY2025", "FY2025-Q4", midnight(2024, 2, 1), midnight(2024, 11, 1)},
+	}
+	for _, tt := range tests {
+		c := Calendar{FiscalYearStart: tt.fiscalYearStart}
+		date := tt.t.Format("2006-01-02")
+		if got := c.Year(tt.t); got != tt.year {
+			t.Errorf("%d Year(%s) = %q, want %q", tt.fiscalYearStart, date, got, tt.year)
+		}
+		if got := c.Quarter(tt.t); got != tt.quarter {
+			t.Errorf("%d Quarter(%s) = %q, want %q", tt.fiscalYearStart, date, got, tt.quarter)
+		}
+		if got := c.FiscalYearStartOf(tt.t); !got.Equal(tt.yearStart) {
+			t.Errorf("%d FiscalYearStartOf(%s) = %s, want %s", tt.fiscalYearStart, date, got, tt.yearStart)
+		}
+		if got := c.QuarterStartOf(tt.t); !got.Equal(tt.quarterStart) {
+			t.Errorf("%d QuarterStartOf(%s) = %s, want %s", tt.fiscalYearStart, date, got, tt.quarterStart)
+		}
+	}
+}
+
+func TestCurrentCalendar(t *testing.T) {
+	prefs := test.NewTempApp(t).Preferences()
+	prefs.SetInt(app.WeekStartKey, int(time.Sunday))
+	prefs.SetInt(app.FiscalYearKey, int(time.July))
+
+	c := CurrentCalendar()
+	if c.WeekStart != time.Sunday || c.FiscalYearStart != time.July {
+		t.Errorf("CurrentCalendar() = %+v, want weeks from Sunday and fiscal years from July", c)
+	}
+	want := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	if got := c.Weekdays(); !slices.Equal(got, want) {
+		t.Errorf("Weekdays() = %v, want %v", got, want)
+	}
+}
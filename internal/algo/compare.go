@@ -0,0 +1,240 @@
+package algo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"github.com/calmdev/algorand-rewards/internal/format"
+)
+
+// ComparisonModes are the available period comparisons.
+var ComparisonModes = []string{"month", "quarter", "year", "custom"}
+
+// PeriodTotals represents the totals of a period.
+type PeriodTotals struct {
+	Label         string
+	Wins          int64
+	FeesCollected float64
+	Bonus         float64
+	Rewards       float64
+	Days          float64
+	StakeDays     float64
+}
+
+// Comparison represents a comparison between a previous and a current period.
+type Comparison struct {
+	Previous PeriodTotals
+	Current  PeriodTotals
+}
+
+// NewComparison compares the current period of the given mode to date with the same days of the previous one.
+//
+// Months are compared with the month before, quarters with the same quarter
+// of the previous year and years with the year before. Both periods are
+// compared from their first day over as much time as has passed in the
+// current period, so a period in progress is not compared with a complete
+// one. The part of today that has passed is compared with the same part of
+// the matching day, counted from the payout blocks. Returns nil if the mode
+// is not a period.
+func NewComparison(r *Rewards, mode string, now time.Time) *Comparison {
+	calendar := CurrentCalendar()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	var start, previousStart, previousLast time.Time
+	switch mode {
+	case "month":
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		previousStart = start.AddDate(0, -1, 0)
+		previousLast = start.AddDate(0, 0, -1)
+	case "quarter":
+		start = calendar.QuarterStartOf(today)
+		previousStart = start.AddDate(-1, 0, 0)
+		previousLast = previousStart.AddDate(0, 3, -1)
+	case "year":
+		start = calendar.FiscalYearStartOf(today)
+		previousStart = start.AddDate(-1, 0, 0)
+		previousLast = start.AddDate(0, 0, -1)
+	default:
+		return nil
+	}
+
+	// The same number of days into the previous period, within the period,
+	// and the same part of the last day as has passed today
+	elapsed := int(today.Sub(start).Hours()/24 + 0.5)
+	previousEnd := previousStart.AddDate(0, 0, elapsed)
+	var previous PeriodTotals
+	if previousEnd.After(previousLast) {
+		previousEnd = previousLast
+		previous = r.RangeTotals(previousStart.Format("2006-01-02"), previousEnd.Format("2006-01-02"))
+	} else {
+		previous = r.RangeTotals(previousStart.Format("2006-01-02"), previousEnd.AddDate(0, 0, -1).Format("2006-01-02"))
+		previous.add(r.partOfDayTotals(previousEnd, now.Sub(today)))
+	}
+
+	previous.Label = fmt.Sprintf("%s to %s", PeriodKey(mode, previousStart), previousEnd.Format("Jan 2"))
+	current := r.RangeTotals(start.Format("2006-01-02"), today.Format("2006-01-02"))
+	current.Label = fmt.Sprintf("%s to %s", PeriodKey(mode, start), today.Format("Jan 2"))
+
+	return &Comparison{
+		Previous: previous,
+		Current:  current,
+	}
+}
+
+// NewRangeComparison compares two custom date ranges (YYYY-MM-DD, inclusive).
+func NewRangeComparison(r *Rewards, previousStart, previousEnd, currentStart, currentEnd string) *Comparison {
+	return &Comparison{
+		Previous: r.RangeTotals(previousStart, previousEnd),
+		Current:  r.RangeTotals(currentStart, currentEnd),
+	}
+}
+
+// RangeTotals returns the totals of the days between start and end (YYYY-MM-DD, inclusive).
+func (r *Rewards) RangeTotals(start, end string) PeriodTotals {
+	var payouts []PayoutDate
+	for _, payout := range r.Daily {
+		if payout.Date >= start && payout.Date <= end {
+			payouts = append(payouts, payout)
+		}
+	}
+
+	return newPeriodTotals(start+" - "+end, payouts)
+}
+
+// partOfDayTotals returns the totals of the blocks in the given time from the start of the day.
+func (r *Rewards) partOfDayTotals(day time.Time, elapsed time.Duration) PeriodTotals {
+	end := day.Add(elapsed)
+	totals := PeriodTotals{Days: elapsed.Hours() / 24}
+	for _, block := range r.Blocks {
+		if block.Time().Before(day) || !block.Time().Before(end) {
+			continue
+		}
+		totals.Wins++
+		totals.FeesCollected += block.AlgoFeesCollected()
+		totals.Bonus += block.AlgoBonus()
+		totals.Rewards += block.AlgoPayout()
+	}
+	if r.Balances != nil {
+		totals.StakeDays = r.Balances.AlgoAverage(day, end) * totals.Days
+	}
+	return totals
+}
+
+// add adds the totals of another period.
+func (t *PeriodTotals) add(other PeriodTotals) {
+	t.Wins += other.Wins
+	t.FeesCollected += other.FeesCollected
+	t.Bonus += other.Bonus
+	t.Rewards += other.Rewards
+	t.Days += other.Days
+	t.StakeDays += other.StakeDays
+}
+
+// newPeriodTotals returns the totals of the given payouts.
+func newPeriodTotals(label string, payouts []PayoutDate) PeriodTotals {
+	totals := PeriodTotals{Label: label}
+	for _, payout := range payouts {
+		totals.Wins += payout.TotalWins
+		totals.FeesCollected += payout.AlgoFeesCollected()
+		totals.Bonus += payout.AlgoBonus()
+		totals.Rewards += payout.AlgoPayout()
+		totals.Days += payout.Days
+		totals.StakeDays += payout.StakeDays
+	}
+	return totals
+}
+
+// ComparisonRow represents a metric compared between two periods.
+type ComparisonRow struct {
+	Metric   string
+	Previous float64
+	Current  float64
+	Delta    float64
+	Change   float64
+	Count    bool
+}
+
+// FormatValue formats a value of the row.
+func (r ComparisonRow) FormatValue(v float64) string {
+	if r.Count {
+		return format.Int(int64(v))
+	}
+	return format.Float(v)
+}
+
+// Rows returns the compared metrics.
+func (c *Comparison) Rows() []ComparisonRow {
+	newRow := func(metric string, previous, current float64) ComparisonRow {
+		row := ComparisonRow{Metric: metric, Previous: previous, Current: current, Delta: current - previous}
+		if previous != 0 {
+			row.Change = row.Delta / previous
+		}
+		return row
+	}
+
+	wins := newRow("Wins", float64(c.Previous.Wins), float64(c.Current.Wins))
+	wins.Count = true
+
+	rows := []ComparisonRow{
+		wins,
+		newRow("Fees Collected", c.Previous.FeesCollected, c.Current.FeesCollected),
+		newRow("Bonus", c.Previous.Bonus, c.Current.Bonus),
+		newRow("Rewards", c.Previous.Rewards, c.Current.Rewards),
+	}
+
+	// Normalise by stake when the balance history is known
+	if c.Previous.StakeDays > 0 && c.Current.StakeDays > 0 {
+		previous, current := c.Previous.payoutDate(), c.Current.payoutDate()
+		rows = append(rows,
+			newRow("Per 1k ALGO", previous.RewardsPerThousand(), current.RewardsPerThousand()),
+			newRow("Wins per 1M ALGO", previous.WinsPerMillion(), current.WinsPerMillion()),
+		)
+	}
+
+	return rows
+}
+
+// payoutDate returns the totals as a payout for the stake-normalised metrics.
+func (t PeriodTotals) payoutDate() PayoutDate {
+	return PayoutDate{
+		Payout:    int64(t.Rewards * 1e6),
+		TotalWins: t.Wins,
+		Days:      t.Days,
+		StakeDays: t.StakeDays,
+	}
+}
+
+// Data returns the data for the comparison.
+func (c *Comparison) Data() [][]string {
+	var data = [][]string{{"Metric", c.Previous.Label, c.Current.Label, "Change", "Change %"}}
+
+	// Append rows to data
+	for _, row := range c.Rows() {
+		data = append(data, []string{
+			row.Metric,
+			row.FormatValue(row.Previous),
+			row.FormatValue(row.Current),
+			row.FormatValue(row.Delta),
+			format.Percent(row.Change),
+		})
+	}
+
+	return data
+}
+
+// ExportComparison exports the comparison to a CSV file.
+func ExportComparison(c *Comparison, writeCloser fyne.URIWriteCloser) {
+	// Create a new CSV writer
+	writer := csv.NewWriter(writeCloser)
+	defer writer.Flush()
+
+	// Write the CSV rows
+	for _, row := range c.Data() {
+		err := writer.Write(row)
+		if err != nil {
+			return
+		}
+	}
+}
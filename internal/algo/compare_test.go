@@ -0,0 +1,99 @@
+package algo
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+func TestNewComparison(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.Local)
+	}
+	// One win of 10 Algo at 6am each day
+	var blocks []BlockHeader
+	for day := date(2025, 1, 1); day.Year() < 2027; day = day.AddDate(0, 0, 1) {
+		blocks = append(blocks, blockAt(int64(len(blocks)+1), day.Add(-6*time.Hour)))
+	}
+
+	tests := []struct {
+		name            string
+		mode            string
+		fiscalYearStart int
+		now             time.Time
+		previous        string
+		previousWins    int64
+		current         string
+		currentWins     int64
+	}{
+		{"early in the month", "month", 1, date(2026, 10, 5), "2026-09 to Sep 5", 5, "2026-10 to Oct 5", 5},
+		{"longer than the previous month", "month", 1, date(2026, 10, 31), "2026-09 to Sep 30", 30, "2026-10 to Oct 31", 31},
+		{"quarter", "quarter", 1, date(2026, 11, 15), "2025-Q4 to Nov 15", 46, "2026-Q4 to Nov 15", 46},
+		{"fiscal quarter", "quarter", 7, date(2026, 10, 19), "FY2026-Q2 to Oct 19", 19, "FY2027-Q2 to Oct 19", 19},
+		{"year", "year", 1, date(2026, 2, 10), "2025 to Feb 10", 41, "2026 to Feb 10", 41},
+		{"fiscal year", "year", 7, date(2026, 10, 19), "FY2026 to Oct 19", 111, "FY2027 to Oct 19", 111},
+		{"before the win of the day", "month", 1, date(2026, 10, 5).Add(-8 * time.Hour), "2026-09 to Sep 5", 4, "2026-10 to Oct 5", 4},
+		{"first day before the win", "month", 1, date(2026, 10, 1).Add(-8 * time.Hour), "2026-09 to Sep 1", 0, "2026-10 to Oct 1", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.NewTempApp(t).Preferences().SetInt(app.FiscalYearKey, tt.fiscalYearStart)
+
+			var past []BlockHeader
+			for _, block := range blocks {
+				if block.Time().Before(tt.now) {
+					past = append(past, block)
+				}
+			}
+			r := NewRewards(dailyPayouts(past, nil, tt.now))
+			r.Blocks = past
+
+			c := NewComparison(r, tt.mode, tt.now)
+			if c.Previous.Label != tt.previous || c.Previous.Wins != tt.previousWins {
+				t.Errorf("Previous = %q with %d wins, want %q with %d wins", c.Previous.Label, c.Previous.Wins, tt.previous, tt.previousWins)
+			}
+			if c.Current.Label != tt.current || c.Current.Wins != tt.currentWins {
+				t.Errorf("Current = %q with %d wins, want %q with %d wins", c.Current.Label, c.Current.Wins, tt.current, tt.currentWins)
+			}
+			if c.Previous.Rewards != float64(10*tt.previousWins) || c.Current.Rewards != float64(10*tt.currentWins) {
+				t.Errorf("Rewards = %g and %g, want %d and %d", c.Previous.Rewards, c.Current.Rewards, 10*tt.previousWins, 10*tt.currentWins)
+			}
+		})
+	}
+}
+
+func TestNewComparisonUnknownMode(t *testing.T) {
+	test.NewTempApp(t)
+
+	for _, mode := range []string{"custom", "week", ""} {
+		if c := NewComparison(&Rewards{}, mode, time.Now()); c != nil {
+			t.Errorf("NewComparison(%q) = %+v, want nil", mode, c)
+		}
+	}
+}
+
+func TestComparisonRows(t *testing.T) {
+	c := &Comparison{
+		Previous: PeriodTotals{Wins: 4, Rewards: 20, Days: 10, StakeDays: 10_000},
+		Current:  PeriodTotals{Wins: 6, Rewards: 15, Days: 10, StakeDays: 10_000},
+	}
+
+	rows := c.Rows()
+	if len(rows) != 6 {
+		t.Fatalf("len(Rows()) = %d, want 6", len(rows))
+	}
+	wins, rewards := rows[0], rows[3]
+	if wins.Delta != 2 || wins.Change != 0.5 || !wins.Count {
+		t.Errorf("wins row = %+v, want a count with delta 2 and change 0.5", wins)
+	}
+	if rewards.Delta != -5 || rewards.Change != -0.25 {
+		t.Errorf("rewards row = %+v, want delta -5 and change -0.25", rewards)
+	}
+
+	c.Previous.StakeDays = 0
+	if rows := c.Rows(); len(rows) != 4 {
+		t.Errorf("len(Rows()) without stake = %d, want 4", len(rows))
+	}
+}
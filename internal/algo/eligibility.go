@@ -0,0 +1,208 @@
+package algo
+
+import (
+	"sync"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/nodely"
+)
+
+const (
+	// StakeWarningMargin is the share of a bound within which the balance is close to it.
+	StakeWarningMargin = 0.1
+
+	// stakeProjectionDays is the number of days the balance trend is projected forward.
+	stakeProjectionDays = 30
+
+	// genesisRetryDuration is how long to wait before fetching the genesis ID again after a failure.
+	genesisRetryDuration = 5 * time.Minute
+)
+
+// StakeBounds represents the balance in microalgos an account must stay within to be eligible for incentives.
+type StakeBounds struct {
+	Min int64
+	Max int64
+}
+
+// NetworkStakeBounds are the stake eligibility bounds of each network by genesis ID.
+//
+// Docs: https://github.com/algorand/go-algorand/blob/master/config/consensus.go
+var NetworkStakeBounds = map[string]StakeBounds{
+	"mainnet-v1.0": {Min: 30_000_000_000, Max: 70_000_000_000_000},
+	"testnet-v1.0": {Min: 30_000_000_000, Max: 70_000_000_000_000},
+	"betanet-v1.0": {Min: 30_000_000_000, Max: 70_000_000_000_000},
+}
+
+// genesisCache holds the genesis ID of the network the app reads from once fetched.
+var genesisCache struct {
+	sync.Mutex
+	id      string
+	checked time.Time // Last fetch attempt
+}
+
+// FetchGenesisID fetches the genesis ID of the network from the transaction params of the nodely api.
+//
+// Docs: https://nodely.io/swagger/index.html?url=/swagger/api/4160/algod.oas3.yml#/public/TransactionParams
+func FetchGenesisID() string {
+	client := nodely.NewClient()
+
+	var params struct {
+		GenesisID string `json:"genesis-id"`
+	}
+	err := client.Get("/v2/transactions/params", &params)
+	if err != nil {
+		return ""
+	}
+
+	return params.GenesisID
+}
+
+// cachedGenesisID returns the genesis ID of the network, fetched until it is known.
+//
+// A failed fetch is retried after genesisRetryDuration, and callers do not
+// wait on a fetch in progress, so an unreachable api costs one request at a
+// time rather than one per caller.
+func cachedGenesisID() string {
+	genesisCache.Lock()
+	if genesisCache.id != "" || time.Since(genesisCache.checked) < genesisRetryDuration {
+		defer genesisCache.Unlock()
+		return genesisCache.id
+	}
+	genesisCache.checked = time.Now()
+	genesisCache.Unlock()
+
+	id := FetchGenesisID()
+
+	genesisCache.Lock()
+	defer genesisCache.Unlock()
+	if id != "" {
+		genesisCache.id = id
+	}
+	return genesisCache.id
+}
+
+// stakeBoundsOf returns the stake eligibility bounds of the network with the
+// genesis ID, or of the default network of the api if it is not known.
+func stakeBoundsOf(genesisID string) StakeBounds {
+	if bounds, ok := NetworkStakeBounds[genesisID]; ok {
+		return bounds
+	}
+	return NetworkStakeBounds[nodely.GenesisID]
+}
+
+// CurrentStakeBounds returns the stake eligibility bounds of the network the app reads from.
+//
+// The bounds of the default network of the api are used while the network
+// cannot be fetched or if it is not known.
+func CurrentStakeBounds() StakeBounds {
+	return stakeBoundsOf(cachedGenesisID())
+}
+
+// KnownStakeBounds returns the stake eligibility bounds of the network last
+// fetched by CurrentStakeBounds without fetching it, for rendering.
+func KnownStakeBounds() StakeBounds {
+	genesisCache.Lock()
+	defer genesisCache.Unlock()
+	return stakeBoundsOf(genesisCache.id)
+}
+
+// AlgoMin returns the minimum balance in Algos.
+func (b StakeBounds) AlgoMin() float64 {
+	return float64(b.Min) / 1e6
+}
+
+// AlgoMax returns the maximum balance in Algos.
+func (b StakeBounds) AlgoMax() float64 {
+	return float64(b.Max) / 1e6
+}
+
+// Status returns where the balance is relative to the bounds.
+//
+// Returns "below", "above", "nearMin", "nearMax" or "ok".
+func (b StakeBounds) Status(balance int64) string {
+	minMargin := int64(float64(b.Min) * StakeWarningMargin)
+	maxMargin := int64(float64(b.Max) * StakeWarningMargin)
+	switch {
+	case balance < b.Min:
+		return "below"
+	case b.Max > 0 && balance > b.Max:
+		return "above"
+	case balance < b.Min+minMargin:
+		return "nearMin"
+	case b.Max > 0 && balance > b.Max-maxMargin:
+		return "nearMax"
+	default:
+		return "ok"
+	}
+}
+
+// EligibilityCheck represents the current, projected and historical balance against the stake bounds.
+type EligibilityCheck struct {
+	Bounds         StakeBounds
+	Balance        int64
+	Projected      int64
+	ProjectionDays int
+	OutOfBounds    []DailyBalance // Days the balance went below the minimum or above the maximum, newest first
+}
+
+// NewEligibilityCheck checks the balance history of the account against the stake bounds.
+//
+// The projected balance continues the balance trend of the last 30 days for
+// another 30 days.
+func NewEligibilityCheck(bounds StakeBounds, h *BalanceHistory, now time.Time) *EligibilityCheck {
+	check := EligibilityCheck{
+		Bounds:         bounds,
+		Balance:        h.Current,
+		ProjectionDays: stakeProjectionDays,
+	}
+	trend := h.Current - h.At(now.AddDate(0, 0, -stakeProjectionDays))
+	check.Projected = max(h.Current+trend, 0)
+
+	if len(h.Changes) > 0 {
+		start := h.Changes[0].Time.Format("2006-01-02")
+		days := h.Daily(start, now.Format("2006-01-02"))
+		for i := len(days) - 1; i >= 0; i-- {
+			if days[i].Min < bounds.Min || (bounds.Max > 0 && days[i].Max > bounds.Max) {
+				check.OutOfBounds = append(check.OutOfBounds, days[i])
+			}
+		}
+	}
+
+	return &check
+}
+
+// FetchEligibilityCheck returns the eligibility check of the account.
+func FetchEligibilityCheck(account *Account) *EligibilityCheck {
+	if account == nil {
+		return nil
+	}
+	rewards := FetchRewards(account.Address)
+	if rewards == nil {
+		return nil
+	}
+	history := FetchBalanceHistory(account, rewards.Blocks)
+	if history == nil {
+		return nil
+	}
+	return NewEligibilityCheck(CurrentStakeBounds(), history, time.Now())
+}
+
+// Status returns where the current balance is relative to the bounds.
+func (c *EligibilityCheck) Status() string {
+	return c.Bounds.Status(c.Balance)
+}
+
+// ProjectedStatus returns where the projected balance is relative to the bounds.
+func (c *EligibilityCheck) ProjectedStatus() string {
+	return c.Bounds.Status(c.Projected)
+}
+
+// AlgoBalance returns the current balance in Algos.
+func (c *EligibilityCheck) AlgoBalance() float64 {
+	return float64(c.Balance) / 1e6
+}
+
+// AlgoProjected returns the projected balance in Algos.
+func (c *EligibilityCheck) AlgoProjected() float64 {
+	return float64(c.Projected) / 1e6
+}
@@ -0,0 +1,152 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/nodely"
+)
+
+func TestStakeBoundsStatus(t *testing.T) {
+	bounds := StakeBounds{Min: 30_000_000_000, Max: 70_000_000_000_000}
+	tests := []struct {
+		name    string
+		balance int64
+		want    string
+	}{
+		{"below", 29_999_999_999, "below"},
+		{"at min", 30_000_000_000, "nearMin"},
+		{"just under near min margin", 32_999_999_999, "nearMin"},
+		{"at near min margin", 33_000_000_000, "ok"},
+		{"ok", 1_000_000_000_000, "ok"},
+		{"at near max margin", 63_000_000_000_000, "ok"},
+		{"just over near max margin", 63_000_000_000_001, "nearMax"},
+		{"at max", 70_000_000_000_000, "nearMax"},
+		{"above", 70_000_000_000_001, "above"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bounds.Status(tt.balance); got != tt.want {
+				t.Errorf("Status(%d) = %q, want %q", tt.balance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStakeBoundsStatusWithoutMax(t *testing.T) {
+	bounds := StakeBounds{Min: 100}
+	if got := bounds.Status(1 << 62); got != "ok" {
+		t.Errorf("Status() = %q, want %q", got, "ok")
+	}
+}
+
+func TestNewEligibilityCheck(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 10, 0, 0, 0, time.Local)
+	}
+	bounds := StakeBounds{Min: 100, Max: 1000}
+
+	tests := []struct {
+		name        string
+		history     *BalanceHistory
+		projected   int64
+		outOfBounds []string
+	}{
+		{
+			name: "below and above",
+			history: &BalanceHistory{
+				Start:   200,
+				Current: 500,
+				Changes: []BalanceChange{
+					{Time: at(9, 1), Round: 1, Delta: -150},
+					{Time: at(9, 2), Round: 2, Delta: 150},
+					{Time: at(10, 1), Round: 3, Delta: 900},
+					{Time: at(10, 2), Round: 4, Delta: -600},
+				},
+			},
+			projected: 800,
+			// The day after a change starts at the balance of the change
+			outOfBounds: []string{"2026-10-02", "2026-10-01", "2026-09-02", "2026-09-01"},
+		},
+		{
+			name: "falling balance stops at zero",
+			history: &BalanceHistory{
+				Start:   500,
+				Current: 150,
+				Changes: []BalanceChange{
+					{Time: at(10, 10), Round: 1, Delta: -350},
+				},
+			},
+			projected: 0,
+		},
+		{
+			name:      "no changes",
+			history:   &BalanceHistory{Start: 500, Current: 500},
+			projected: 500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := NewEligibilityCheck(bounds, tt.history, now)
+			if check.Balance != tt.history.Current {
+				t.Errorf("Balance = %d, want %d", check.Balance, tt.history.Current)
+			}
+			if check.Projected != tt.projected {
+				t.Errorf("Projected = %d, want %d", check.Projected, tt.projected)
+			}
+			if check.ProjectionDays != stakeProjectionDays {
+				t.Errorf("ProjectionDays = %d, want %d", check.ProjectionDays, stakeProjectionDays)
+			}
+			var dates []string
+			for _, day := range check.OutOfBounds {
+				dates = append(dates, day.Date)
+			}
+			if !slices.Equal(dates, tt.outOfBounds) {
+				t.Errorf("OutOfBounds = %v, want %v", dates, tt.outOfBounds)
+			}
+		})
+	}
+}
+
+func TestCachedGenesisIDAfterFailure(t *testing.T) {
+	defer func() {
+		genesisCache.id, genesisCache.checked = "", time.Time{}
+	}()
+
+	// A recent failed fetch is not retried
+	genesisCache.id, genesisCache.checked = "", time.Now()
+	if got := cachedGenesisID(); got != "" {
+		t.Errorf("cachedGenesisID() = %q, want %q", got, "")
+	}
+	if got := KnownStakeBounds(); got != NetworkStakeBounds[nodely.GenesisID] {
+		t.Errorf("KnownStakeBounds() = %+v, want the bounds of the default network", got)
+	}
+
+	// A known network is never fetched again
+	genesisCache.id, genesisCache.checked = "testnet-v1.0", time.Time{}
+	if got := cachedGenesisID(); got != "testnet-v1.0" {
+		t.Errorf("cachedGenesisID() = %q, want %q", got, "testnet-v1.0")
+	}
+}
+
+func TestStakeBoundsOf(t *testing.T) {
+	custom := StakeBounds{Min: 1, Max: 2}
+	NetworkStakeBounds["custom-v1.0"] = custom
+	defer delete(NetworkStakeBounds, "custom-v1.0")
+
+	tests := []struct {
+		genesisID string
+		want      StakeBounds
+	}{
+		{"custom-v1.0", custom},
+		{"unknown-v1.0", NetworkStakeBounds[nodely.GenesisID]},
+		{"", NetworkStakeBounds[nodely.GenesisID]},
+	}
+	for _, tt := range tests {
+		if got := stakeBoundsOf(tt.genesisID); got != tt.want {
+			t.Errorf("stakeBoundsOf(%q) = %+v, want %+v", tt.genesisID, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package algo
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/nodely"
+)
+
+// Fleet sort columns.
+const (
+	FleetSortProblems  = "problems"
+	FleetSortAddress   = "address"
+	FleetSortProposal  = "proposal"
+	FleetSortHeartbeat = "heartbeat"
+	FleetSortExpiry    = "expiry"
+	FleetSortRewards7  = "rewards7"
+	FleetSortRewards30 = "rewards30"
+)
+
+// FleetEntry represents the state and recent rewards of one account in the fleet.
+type FleetEntry struct {
+	Address   string
+	Account   *Account
+	Round     int64
+	RoundTime time.Duration
+	Rewards7  float64
+	Wins7     int64
+	Rewards30 float64
+	Wins30    int64
+	Absent    *Absenteeism
+	KeyExpiry *KeyExpiry
+	Problems  []HealthItem // Warnings and errors of the account, worst first
+}
+
+// NewFleetEntry aggregates the blocks proposed by the account over the last
+// 7 and 30 days and collects the problems of the account.
+//
+// The blocks are grouped by day and summed over the same windows as the
+// trends of the rewards view, the complete calendar days before today.
+//
+// The account may be nil when it could not be fetched. The luck may be nil
+// when the network state could not be fetched, in which case the current
+// round is reported as unknown rather than judging the account against it.
+func NewFleetEntry(address string, account *Account, blocks []BlockHeader, luck *Luck, now time.Time) *FleetEntry {
+	entry := FleetEntry{Address: address, Account: account}
+	if account == nil {
+		entry.Problems = []HealthItem{{Label: "Account", Value: "Unavailable", Level: HealthError}}
+		return &entry
+	}
+
+	// Aggregate the rewards of the last 7 and 30 days
+	daily := dailyPayouts(blocks, nil, now)
+	week, month := NewTrend(daily, 7, now), NewTrend(daily, 30, now)
+	entry.Rewards7, entry.Wins7 = week.Rewards, week.Wins
+	entry.Rewards30, entry.Wins30 = month.Rewards, month.Wins
+
+	if luck != nil {
+		entry.Round = luck.Round
+		entry.RoundTime = luck.RoundTime
+		entry.Absent = NewAbsenteeism(account, luck)
+		if account.Participation != nil {
+			entry.KeyExpiry = NewKeyExpiry(account, luck.Round, luck.RoundTime, now)
+		}
+	}
+
+	// Keep the problems of the account from its health, worst first
+	status := &NodeStatus{LastRound: entry.Round}
+	if luck == nil {
+		status = nil
+	}
+	health := NewHealth(account, status, nil, entry.Absent, entry.KeyExpiry)
+	for _, item := range health.Items {
+		if item.Label == "Indexer Lag" || item.Label == "Current Round" {
+			continue
+		}
+		if item.Level != HealthOK {
+			entry.Problems = append(entry.Problems, item)
+		}
+	}
+	if luck == nil {
+		entry.Problems = append(entry.Problems, HealthItem{Label: "Current Round", Value: "Unknown", Level: HealthWarning})
+	}
+	if message := stakeProblem(account); message != "" {
+		entry.Problems = append(entry.Problems, HealthItem{Label: "Stake Eligibility", Value: message, Level: stakeLevel(account)})
+	}
+	sort.SliceStable(entry.Problems, func(i, j int) bool {
+		return entry.Problems[i].Level == HealthError && entry.Problems[j].Level != HealthError
+	})
+
+	return &entry
+}
+
+// stakeProblem returns the balance status of an online account against the stake bounds, or an empty string if ok.
+func stakeProblem(account *Account) string {
+	if !account.Online() {
+		return ""
+	}
+	switch KnownStakeBounds().Status(account.Amount) {
+	case "below":
+		return "Below minimum"
+	case "above":
+		return "Above maximum"
+	case "nearMin":
+		return "Near minimum"
+	case "nearMax":
+		return "Near maximum"
+	default:
+		return ""
+	}
+}
+
+// stakeLevel returns the health level of the balance of the account against the stake bounds.
+func stakeLevel(account *Account) string {
+	switch KnownStakeBounds().Status(account.Amount) {
+	case "below", "above":
+		return HealthError
+	case "nearMin", "nearMax":
+		return HealthWarning
+	default:
+		return HealthOK
+	}
+}
+
+// fleetConcurrency is the number of accounts of the fleet fetched at once.
+const fleetConcurrency = 4
+
+// FetchFleet fetches the accounts and the blocks proposed over the last 30 days before today for each address.
+//
+// The blocks are fetched without the rewards cache, which only holds the
+// blocks of the configured address.
+func FetchFleet(addresses []string) []*FleetEntry {
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day()-30, 0, 0, 0, 0, time.Local)
+
+	// The network, online stake and round time are shared by all accounts
+	CurrentStakeBounds()
+	supply := cachedSupply()
+	var roundTime time.Duration
+	if supply != nil {
+		roundTime = cachedAverageRoundTime(supply.CurrentRound)
+	}
+
+	entries := make([]*FleetEntry, len(addresses))
+	sem := make(chan struct{}, fleetConcurrency)
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			account := FetchAccount(address)
+			var blocks []BlockHeader
+			var luck *Luck
+			if account != nil {
+				blocks = fetchBlockHeadersRecursive(nodely.NewClientIndexer(), address, "", []BlockHeader{}, since)
+				if supply != nil {
+					luck = NewLuck(account, supply, roundTime)
+				}
+			}
+			entries[i] = NewFleetEntry(address, account, blocks, luck, now)
+		}(i, address)
+	}
+	wg.Wait()
+
+	return entries
+}
+
+// ParseFleetAddresses returns the addresses in the text, one per line or separated by commas.
+func ParseFleetAddresses(text string) []string {
+	var addresses []string
+	for _, field := range strings.FieldsFunc(text, func(r rune) bool {
+		return r == '\n' || r == ',' || r == ' ' || r == '\t' || r == '\r'
+	}) {
+		if !slices.Contains(addresses, field) {
+			addresses = append(addresses, field)
+		}
+	}
+	return addresses
+}
+
+// Level returns the worst level of the problems of the entry.
+func (e *FleetEntry) Level() string {
+	level := HealthOK
+	for _, problem := range e.Problems {
+		switch problem.Level {
+		case HealthError:
+			return HealthError
+		case HealthWarning:
+			level = HealthWarning
+		}
+	}
+	return level
+}
+
+// RoundUnknown returns true if the current round could not be fetched, so the times since the last proposal and heartbeat are not known.
+func (e *FleetEntry) RoundUnknown() bool {
+	return e.Account != nil && e.Round == 0
+}
+
+// RoundsSinceProposal returns the rounds since the account last proposed a block, or -1 if never.
+func (e *FleetEntry) RoundsSinceProposal() int64 {
+	if e.Account == nil || e.Account.LastProposed == 0 || e.Round == 0 {
+		return -1
+	}
+	return max(e.Round-e.Account.LastProposed, 0)
+}
+
+// RoundsSinceHeartbeat returns the rounds since the account last sent a heartbeat, or -1 if never.
+func (e *FleetEntry) RoundsSinceHeartbeat() int64 {
+	if e.Account == nil || e.Account.LastHeartbeat == 0 || e.Round == 0 {
+		return -1
+	}
+	return max(e.Round-e.Account.LastHeartbeat, 0)
+}
+
+// SinceProposal returns the estimated time since the account last proposed a block, or 0 if unknown.
+func (e *FleetEntry) SinceProposal() time.Duration {
+	return time.Duration(max(e.RoundsSinceProposal(), 0)) * e.RoundTime
+}
+
+// SinceHeartbeat returns the estimated time since the account last sent a heartbeat, or 0 if unknown.
+func (e *FleetEntry) SinceHeartbeat() time.Duration {
+	return time.Duration(max(e.RoundsSinceHeartbeat(), 0)) * e.RoundTime
+}
+
+// levelRank returns the rank of a health level, higher being worse.
+func levelRank(level string) int {
+	switch level {
+	case HealthError:
+		return 2
+	case HealthWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SortFleet sorts the entries by the given column.
+//
+// Problems sort worst first, times since the last proposal and heartbeat
+// longest first with never last, key expiry soonest first with no key last,
+// and rewards highest first. Ties are sorted by address.
+func SortFleet(entries []*FleetEntry, column string) {
+	// elapsed orders the rounds since an event longest first with never last.
+	elapsed := func(a, b int64) int {
+		switch {
+		case a == b:
+			return 0
+		case a < 0:
+			return 1
+		case b < 0:
+			return -1
+		case a > b:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	// compare returns a negative number if a sorts before b, positive if after and 0 if equal.
+	compare := func(a, b *FleetEntry) int {
+		switch column {
+		case FleetSortAddress:
+			return 0
+		case FleetSortProposal:
+			return elapsed(a.RoundsSinceProposal(), b.RoundsSinceProposal())
+		case FleetSortHeartbeat:
+			return elapsed(a.RoundsSinceHeartbeat(), b.RoundsSinceHeartbeat())
+		case FleetSortExpiry:
+			switch {
+			case a.KeyExpiry == nil && b.KeyExpiry == nil:
+				return 0
+			case a.KeyExpiry == nil:
+				return 1
+			case b.KeyExpiry == nil:
+				return -1
+			}
+			return cmp.Compare(a.KeyExpiry.LastValid, b.KeyExpiry.LastValid)
+		case FleetSortRewards7:
+			return cmp.Compare(b.Rewards7, a.Rewards7)
+		case FleetSortRewards30:
+			return cmp.Compare(b.Rewards30, a.Rewards30)
+		default:
+			if rank := levelRank(b.Level()) - levelRank(a.Level()); rank != 0 {
+				return rank
+			}
+			return len(b.Problems) - len(a.Problems)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if c := compare(entries[i], entries[j]); c != 0 {
+			return c < 0
+		}
+		return entries[i].Address < entries[j].Address
+	})
+}
@@ -0,0 +1,124 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewFleetEntryUnavailable(t *testing.T) {
+	entry := NewFleetEntry("A", nil, nil, nil, time.Now())
+	if len(entry.Problems) != 1 || entry.Problems[0].Label != "Account" {
+		t.Errorf("Problems = %+v, want the account unavailable", entry.Problems)
+	}
+	if entry.Level() != HealthError {
+		t.Errorf("Level() = %q, want %q", entry.Level(), HealthError)
+	}
+	if entry.RoundUnknown() {
+		t.Error("RoundUnknown() = true without an account")
+	}
+}
+
+func TestNewFleetEntryRewards(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 9, 18, 23, 0, 0, 0, time.Local)),  // Before both windows
+		blockAt(2, time.Date(2026, 9, 19, 1, 0, 0, 0, time.Local)),   // First day of the 30 day window
+		blockAt(3, time.Date(2026, 10, 11, 23, 0, 0, 0, time.Local)), // Day before the 7 day window
+		blockAt(4, time.Date(2026, 10, 12, 1, 0, 0, 0, time.Local)),  // First day of the 7 day window
+		blockAt(5, time.Date(2026, 10, 18, 23, 0, 0, 0, time.Local)), // Yesterday
+		blockAt(6, time.Date(2026, 10, 19, 9, 0, 0, 0, time.Local)),  // Today is not over yet
+	}
+
+	entry := NewFleetEntry("A", &Account{Address: "A", Status: "Offline"}, blocks, nil, now)
+	if entry.Wins7 != 2 || entry.Rewards7 != 20 {
+		t.Errorf("7 days = %.2f rewards and %d wins, want 20 and 2", entry.Rewards7, entry.Wins7)
+	}
+	if entry.Wins30 != 4 || entry.Rewards30 != 40 {
+		t.Errorf("30 days = %.2f rewards and %d wins, want 40 and 4", entry.Rewards30, entry.Wins30)
+	}
+
+	// Matches the trend of the rewards view
+	trend := NewTrend(dailyPayouts(blocks, nil, now), 30, now)
+	if entry.Rewards30 != trend.Rewards || entry.Wins30 != trend.Wins {
+		t.Errorf("30 days = %.2f and %d, want the trend %.2f and %d", entry.Rewards30, entry.Wins30, trend.Rewards, trend.Wins)
+	}
+}
+
+func TestNewFleetEntryUnknownRound(t *testing.T) {
+	entry := NewFleetEntry("A", &Account{Address: "A", Status: "Offline", LastProposed: 10}, nil, nil, time.Now())
+	if !entry.RoundUnknown() {
+		t.Error("RoundUnknown() = false without the network state")
+	}
+	if got := entry.RoundsSinceProposal(); got != -1 {
+		t.Errorf("RoundsSinceProposal() = %d, want -1", got)
+	}
+	if !slices.ContainsFunc(entry.Problems, func(item HealthItem) bool {
+		return item.Label == "Current Round" && item.Level == HealthWarning
+	}) {
+		t.Errorf("Problems = %+v, want the current round unknown", entry.Problems)
+	}
+
+	// Errors sort before warnings
+	for i := 1; i < len(entry.Problems); i++ {
+		if entry.Problems[i-1].Level != HealthError && entry.Problems[i].Level == HealthError {
+			t.Errorf("Problems = %+v, want errors first", entry.Problems)
+			break
+		}
+	}
+}
+
+func TestSortFleet(t *testing.T) {
+	warning := []HealthItem{{Level: HealthWarning}}
+	entries := []*FleetEntry{
+		{Address: "A", Round: 1000, Account: &Account{LastProposed: 900, LastHeartbeat: 990}, Rewards7: 5, KeyExpiry: &KeyExpiry{LastValid: 3000}, Problems: warning},
+		{Address: "B", Round: 1000, Account: &Account{}, Rewards7: 5, KeyExpiry: &KeyExpiry{LastValid: 2000}},
+		{Address: "C", Round: 1000, Account: &Account{LastProposed: 500, LastHeartbeat: 100}, Rewards7: 9, Problems: append(warning, warning...)},
+		{Address: "D", Problems: []HealthItem{{Level: HealthError}}},
+	}
+
+	tests := []struct {
+		column string
+		want   []string
+	}{
+		{FleetSortProblems, []string{"D", "C", "A", "B"}},
+		{FleetSortAddress, []string{"A", "B", "C", "D"}},
+		{FleetSortProposal, []string{"C", "A", "B", "D"}},  // Never last
+		{FleetSortHeartbeat, []string{"C", "A", "B", "D"}}, // Never last
+		{FleetSortExpiry, []string{"B", "A", "C", "D"}},    // No key last
+		{FleetSortRewards7, []string{"C", "A", "B", "D"}},  // Ties by address
+	}
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			sorted := slices.Clone(entries)
+			slices.Reverse(sorted)
+			SortFleet(sorted, tt.column)
+
+			var got []string
+			for _, entry := range sorted {
+				got = append(got, entry.Address)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SortFleet(%q) = %v, want %v", tt.column, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFleetAddresses(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"A", []string{"A"}},
+		{"A\nB\r\nC", []string{"A", "B", "C"}},
+		{"A, B,C\tD", []string{"A", "B", "C", "D"}},
+		{"A\n\n  A\nB", []string{"A", "B"}},
+	}
+	for _, tt := range tests {
+		if got := ParseFleetAddresses(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("ParseFleetAddresses(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
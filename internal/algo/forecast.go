@@ -0,0 +1,219 @@
+package algo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"github.com/calmdev/algorand-rewards/internal/format"
+)
+
+const (
+	// forecastHistoryDays is the number of days of history used for the forecast.
+	forecastHistoryDays = 90
+
+	// forecastZScore is the z-score of the 90% confidence band.
+	forecastZScore = 1.645
+)
+
+// ForecastHorizons are the number of days to project rewards for.
+var ForecastHorizons = []int{30, 90, 365}
+
+// Projection represents projected rewards for a number of days.
+type Projection struct {
+	Days         int
+	ExpectedWins float64
+	LowWins      float64
+	HighWins     float64
+	Bonus        float64
+	Fees         float64
+	Rewards      float64
+	LowRewards   float64
+	HighRewards  float64
+}
+
+// Forecast represents projected rewards and the assumptions behind them.
+type Forecast struct {
+	Projections  []Projection
+	Assumptions  []string
+	WinsPerDay   float64
+	FeesPerWin   float64
+	BlocksPerDay float64
+	Round        int64
+	Plan         BonusPlan
+}
+
+// NewForecast creates a new Forecast from the daily payouts and the current stake.
+//
+// Wins are modelled as a Poisson process at the rate implied by the stake
+// share, falling back to the historical win rate when the stake is unknown.
+// Every win pays the scheduled bonus of its round plus the average fees per
+// win of recent history.
+func NewForecast(r *Rewards, account *Account, luck *Luck, plan BonusPlan) *Forecast {
+	forecast := Forecast{Plan: plan, BlocksPerDay: float64(24*time.Hour) / float64(DefaultRoundTime)}
+
+	// Summarize recent history
+	history := r.Daily
+	if len(history) > forecastHistoryDays {
+		history = history[len(history)-forecastHistoryDays:]
+	}
+	var days, fees float64
+	var wins int64
+	for _, payout := range history {
+		days += payout.Days
+		wins += payout.TotalWins
+		fees += payout.AlgoPayout() - payout.AlgoBonus()
+	}
+	if wins > 0 {
+		forecast.FeesPerWin = math.Max(fees/float64(wins), 0)
+	}
+
+	// Use the stake share when known, otherwise the historical win rate
+	if luck != nil && luck.ExpectedWinsPerDay() > 0 {
+		forecast.WinsPerDay = luck.ExpectedWinsPerDay()
+		forecast.BlocksPerDay = luck.BlocksPerDay
+		forecast.Round = luck.Round
+	} else if days > 0 {
+		forecast.WinsPerDay = float64(wins) / days
+		forecast.Round = estimateRound(r.Blocks, forecast.BlocksPerDay, time.Now())
+	}
+
+	for _, horizon := range ForecastHorizons {
+		forecast.Projections = append(forecast.Projections, forecast.project(horizon))
+	}
+
+	// List the assumptions behind the forecast
+	if account != nil {
+		forecast.Assumptions = append(forecast.Assumptions,
+			fmt.Sprintf("Stake of %s Algo stays constant.", format.FloatShort(account.AlgoBalance())))
+	}
+	if luck != nil && luck.ExpectedWinsPerDay() > 0 {
+		forecast.Assumptions = append(forecast.Assumptions,
+			fmt.Sprintf("Stake share of %s of the online stake stays constant.", format.Percent(luck.StakeShare)),
+			fmt.Sprintf("Average round time of %s.", luck.RoundTime.Round(time.Millisecond)))
+	} else {
+		forecast.Assumptions = append(forecast.Assumptions,
+			fmt.Sprintf("Win rate of the last %d days continues.", forecastHistoryDays),
+			"Current round estimated from the last proposed block.")
+	}
+	forecast.Assumptions = append(forecast.Assumptions,
+		fmt.Sprintf("%s expected wins per day.", format.FloatShort(forecast.WinsPerDay)),
+		fmt.Sprintf("%s Algo in fees per win, averaged over the last %d days.", format.Float(forecast.FeesPerWin), forecastHistoryDays),
+		fmt.Sprintf("Bonus of %s Algo per block, decaying %.0f%% every %s rounds.",
+			format.Float(plan.AlgoBonusAt(forecast.Round)), plan.DecayPercent, format.Int(plan.DecayInterval)),
+		"Account stays online and incentive eligible.",
+		"Bands are 90% confidence intervals of a Poisson process.",
+	)
+
+	return &forecast
+}
+
+// estimateRound estimates the current round from the most recent block, or returns 0 if there are no blocks.
+func estimateRound(blocks []BlockHeader, blocksPerDay float64, now time.Time) int64 {
+	var last *BlockHeader
+	for i := range blocks {
+		if last == nil || blocks[i].Round > last.Round {
+			last = &blocks[i]
+		}
+	}
+	if last == nil {
+		return 0
+	}
+	elapsed := math.Max(now.Sub(last.Time()).Hours()/24, 0)
+	return last.Round + int64(elapsed*blocksPerDay)
+}
+
+// project returns the projected rewards for the given number of days.
+func (f *Forecast) project(days int) Projection {
+	projection := Projection{Days: days}
+
+	var variance float64
+	for d := 0; d < days; d++ {
+		// Use the bonus scheduled for the middle of the day
+		round := f.Round + int64((float64(d)+0.5)*f.BlocksPerDay)
+		bonus := 0.0
+		if f.Round > 0 {
+			bonus = f.Plan.AlgoBonusAt(round)
+		}
+		perWin := bonus + f.FeesPerWin
+
+		projection.ExpectedWins += f.WinsPerDay
+		projection.Bonus += f.WinsPerDay * bonus
+		projection.Fees += f.WinsPerDay * f.FeesPerWin
+		variance += f.WinsPerDay * perWin * perWin
+	}
+	projection.Rewards = projection.Bonus + projection.Fees
+
+	winsBand := forecastZScore * math.Sqrt(projection.ExpectedWins)
+	projection.LowWins = math.Max(projection.ExpectedWins-winsBand, 0)
+	projection.HighWins = projection.ExpectedWins + winsBand
+
+	rewardsBand := forecastZScore * math.Sqrt(variance)
+	projection.LowRewards = math.Max(projection.Rewards-rewardsBand, 0)
+	projection.HighRewards = projection.Rewards + rewardsBand
+
+	return projection
+}
+
+// Data returns the data for the forecast.
+func (f *Forecast) Data() [][]string {
+	var data = [][]string{{"Days", "Expected Wins", "Low Wins", "High Wins", "Bonus", "Fees", "Rewards", "Low Rewards", "High Rewards"}}
+
+	// Append projections to data
+	for _, p := range f.Projections {
+		data = append(data, []string{
+			fmt.Sprintf("%d", p.Days),
+			format.FloatShort(p.ExpectedWins),
+			format.FloatShort(p.LowWins),
+			format.FloatShort(p.HighWins),
+			format.Float(p.Bonus),
+			format.Float(p.Fees),
+			format.Float(p.Rewards),
+			format.Float(p.LowRewards),
+			format.Float(p.HighRewards),
+		})
+	}
+
+	return data
+}
+
+// FetchForecast returns the forecast for the given address.
+func FetchForecast(address string) *Forecast {
+	rewards := FetchRewards(address)
+	if rewards == nil {
+		return nil
+	}
+
+	account := FetchAccount(address)
+	return NewForecast(rewards, account, FetchLuck(account), MainnetBonusPlan)
+}
+
+// ExportForecast exports the forecast to a CSV file.
+func ExportForecast(address string, writeCloser fyne.URIWriteCloser) {
+	// Create a new CSV writer
+	writer := csv.NewWriter(writeCloser)
+	defer writer.Flush()
+
+	forecast := FetchForecast(address)
+	if forecast == nil {
+		return
+	}
+
+	// Write the CSV rows
+	for _, row := range forecast.Data() {
+		err := writer.Write(row)
+		if err != nil {
+			return
+		}
+	}
+
+	// Write the assumptions
+	for _, assumption := range forecast.Assumptions {
+		err := writer.Write([]string{"Assumption", assumption})
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package algo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/format"
+)
+
+// goalRateWindow is the number of recent days used to estimate the reward rate.
+const goalRateWindow = 30
+
+// Goal represents progress towards a reward target.
+type Goal struct {
+	Label      string
+	Target     float64
+	Progress   float64
+	Rate       float64 // Recent rewards per day
+	Deadline   time.Time
+	Completion time.Time
+}
+
+// NewGoals creates the monthly and year-end goals with a target greater than zero.
+//
+// The monthly goal tracks the rewards earned this month and the year-end goal
+// the rewards earned this fiscal year, which is the calendar year unless
+// another first month is set. Completion is estimated from the recent rate.
+func NewGoals(r *Rewards, monthly, yearly float64, now time.Time) []Goal {
+	trend := NewTrend(r.Daily, goalRateWindow, now)
+	rate := trend.Rewards / goalRateWindow
+
+	var goals []Goal
+	if monthly > 0 {
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		goals = append(goals, newGoal("Monthly Goal", monthly, r, start, start.AddDate(0, 1, 0), rate, now))
+	}
+	if yearly > 0 {
+		calendar := CurrentCalendar()
+		label := "Year-End Goal"
+		if calendar.FiscalYearStart > time.January {
+			label = "Fiscal Year-End Goal"
+		}
+		start := calendar.FiscalYearStartOf(now)
+		goals = append(goals, newGoal(label, yearly, r, start, start.AddDate(1, 0, 0), rate, now))
+	}
+
+	return goals
+}
+
+// newGoal returns a goal with the rewards earned from start.
+func newGoal(label string, target float64, r *Rewards, start, deadline time.Time, rate float64, now time.Time) Goal {
+	goal := Goal{Label: label, Target: target, Rate: rate, Deadline: deadline}
+
+	since := start.Format("2006-01-02")
+	for _, payout := range r.Daily {
+		if payout.Date >= since {
+			goal.Progress += payout.AlgoPayout()
+		}
+	}
+
+	switch {
+	case goal.Complete():
+		goal.Completion = now
+	case rate > 0:
+		days := (target - goal.Progress) / rate
+		goal.Completion = now.Add(time.Duration(days * 24 * float64(time.Hour)))
+	}
+
+	return goal
+}
+
+// Complete returns true if the target has been reached.
+func (g Goal) Complete() bool {
+	return g.Progress >= g.Target
+}
+
+// Ratio returns the progress towards the target between 0 and 1.
+func (g Goal) Ratio() float64 {
+	if g.Target <= 0 {
+		return 0
+	}
+	return math.Min(g.Progress/g.Target, 1)
+}
+
+// OnTrack returns true if the target is expected to be reached before the deadline.
+func (g Goal) OnTrack() bool {
+	return !g.Completion.IsZero() && g.Completion.Before(g.Deadline)
+}
+
+// Milestone represents a reward milestone and the block at which it was reached.
+type Milestone struct {
+	Label string
+	Round int64
+	Time  time.Time
+}
+
+// milestoneWins are the win counts recorded as milestones.
+var milestoneWins = []int64{1, 10, 100, 1000}
+
+// milestoneRewards are the cumulative rewards in ALGO recorded as milestones.
+var milestoneRewards = []float64{100, 1000, 10000, 100000}
+
+// NewMilestones returns the milestones reached by the proposed blocks, oldest first.
+func NewMilestones(r *Rewards) []Milestone {
+	blocks := make([]BlockHeader, len(r.Blocks))
+	copy(blocks, r.Blocks)
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Round < blocks[j].Round
+	})
+
+	var milestones []Milestone
+	var wins int64
+	var rewards float64
+	var nextWins, nextRewards int
+	for _, block := range blocks {
+		wins++
+		rewards += block.AlgoPayout()
+
+		if nextWins < len(milestoneWins) && wins == milestoneWins[nextWins] {
+			label := "First Win"
+			if wins > 1 {
+				label = fmt.Sprintf("%s Wins", format.Int(wins))
+			}
+			milestones = append(milestones, Milestone{Label: label, Round: block.Round, Time: block.Time()})
+			nextWins++
+		}
+		for nextRewards < len(milestoneRewards) && rewards >= milestoneRewards[nextRewards] {
+			label := fmt.Sprintf("%s ALGO", format.Int(int64(milestoneRewards[nextRewards])))
+			milestones = append(milestones, Milestone{Label: label, Round: block.Round, Time: block.Time()})
+			nextRewards++
+		}
+	}
+
+	return milestones
+}
@@ -0,0 +1,40 @@
+package algo
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+func TestNewGoalsFiscalYear(t *testing.T) {
+	now := noon(2026, 10, 19)
+	// 10 Algo on the last day of June and on the first day of July
+	r := NewRewards(winsOn("2026-06-30", "2026-07-01"))
+
+	tests := []struct {
+		name            string
+		fiscalYearStart time.Month
+		label           string
+		progress        float64
+		deadline        time.Time
+	}{
+		{"calendar year", time.January, "Year-End Goal", 20, midnight(2027, 1, 1)},
+		{"fiscal year", time.July, "Fiscal Year-End Goal", 10, midnight(2027, 7, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test.NewTempApp(t).Preferences().SetInt(app.FiscalYearKey, int(tt.fiscalYearStart))
+
+			goals := NewGoals(r, 0, 100, now)
+			if len(goals) != 1 {
+				t.Fatalf("len(NewGoals()) = %d, want 1", len(goals))
+			}
+			goal := goals[0]
+			if goal.Label != tt.label || goal.Progress != tt.progress || !goal.Deadline.Equal(tt.deadline) {
+				t.Errorf("goal = %q with %g by %s, want %q with %g by %s", goal.Label, goal.Progress, goal.Deadline, tt.label, tt.progress, tt.deadline)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package algo
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/format"
+	"github.com/calmdev/algorand-rewards/internal/nodely"
+)
+
+// Health levels of a health item.
+const (
+	HealthOK      = "ok"
+	HealthWarning = "warning"
+	HealthError   = "error"
+)
+
+const (
+	// proposalWarningIntervals is how many expected proposal intervals may pass before warning.
+	proposalWarningIntervals = 5
+
+	// proposalErrorIntervals is how many expected proposal intervals may pass before erroring.
+	proposalErrorIntervals = 10
+
+	// indexerWarningLag is the number of rounds the indexer may lag behind algod before warning.
+	indexerWarningLag = 10
+
+	// indexerErrorLag is the number of rounds the indexer may lag behind algod before erroring.
+	indexerErrorLag = 100
+
+	// stalledRoundTime is how long since the last round before algod is considered stalled.
+	stalledRoundTime = 30 * time.Second
+)
+
+// IndexerHealth represents the health of the indexer.
+type IndexerHealth struct {
+	Round       int64  `json:"round"`
+	DBAvailable bool   `json:"db-available"`
+	IsMigrating bool   `json:"is-migrating"`
+	Message     string `json:"message"`
+	Version     string `json:"version"`
+}
+
+// FetchIndexerHealth fetches the indexer health from the nodely api.
+//
+// Docs: https://nodely.io/swagger/index.html?url=/swagger/api/4160/indexer.oas3.yml#/common/makeHealthCheck
+func FetchIndexerHealth() *IndexerHealth {
+	client := nodely.NewClientIndexer()
+
+	var health IndexerHealth
+	err := client.Get("/health", &health)
+	if err != nil || health.Round == 0 {
+		return nil
+	}
+
+	return &health
+}
+
+// HealthItem represents one part of the operational state of an account.
+type HealthItem struct {
+	Label  string
+	Value  string
+	Detail string
+	Level  string
+}
+
+// Health represents the operational state of an account.
+type Health struct {
+	Items []HealthItem
+}
+
+// NewHealth combines the operational state of an account into health items.
+//
+// Any of the inputs except the account may be nil when unavailable.
+func NewHealth(account *Account, status *NodeStatus, indexer *IndexerHealth, absent *Absenteeism, expiry *KeyExpiry) *Health {
+	var health Health
+
+	// Online status
+	online := HealthItem{Label: "Online Status", Value: account.Status, Level: HealthOK}
+	if !account.Online() {
+		online.Level = HealthError
+		online.Detail = "The account is not participating in consensus."
+	}
+	health.Items = append(health.Items, online)
+
+	// Incentive eligibility
+	eligible := HealthItem{Label: "Incentive Eligibility", Value: "Eligible", Level: HealthOK}
+	if !account.IncentiveEligible {
+		eligible.Value = "Not eligible"
+		eligible.Level = HealthError
+		eligible.Detail = "Register online with the eligibility fee to earn proposer rewards."
+	}
+	health.Items = append(health.Items, eligible)
+
+	// Current round
+	round := HealthItem{Label: "Current Round", Value: "Unavailable", Level: HealthError}
+	if status != nil {
+		round.Value = format.Int(status.LastRound)
+		round.Level = HealthOK
+		if since := time.Duration(status.TimeSinceLastRound); since > stalledRoundTime {
+			round.Level = HealthWarning
+			round.Detail = fmt.Sprintf("No new round for %s.", format.Duration(since))
+		}
+	}
+	health.Items = append(health.Items, round)
+
+	// Rounds since last proposal
+	proposal := HealthItem{Label: "Since Last Proposal", Value: "Never", Level: HealthOK}
+	if account.LastProposed > 0 && status != nil {
+		rounds := max(status.LastRound-account.LastProposed, 0)
+		proposal.Value = fmt.Sprintf("%s rounds", format.Int(rounds))
+		if absent != nil {
+			intervals := float64(rounds) / absent.ExpectedInterval
+			proposal.Detail = fmt.Sprintf("Expected every %s rounds.", format.Int(int64(absent.ExpectedInterval)))
+			switch {
+			case intervals >= proposalErrorIntervals:
+				proposal.Level = HealthError
+			case intervals >= proposalWarningIntervals:
+				proposal.Level = HealthWarning
+			}
+		}
+	}
+	health.Items = append(health.Items, proposal)
+
+	// Rounds since last heartbeat, rated by how close the account is to being absent
+	heartbeat := HealthItem{Label: "Since Last Heartbeat", Value: "Never", Level: HealthOK}
+	if account.LastHeartbeat > 0 && status != nil {
+		heartbeat.Value = fmt.Sprintf("%s rounds", format.Int(max(status.LastRound-account.LastHeartbeat, 0)))
+	}
+	if absent != nil {
+		heartbeat.Detail = fmt.Sprintf("Absent in %s rounds without a proposal or heartbeat.", format.Int(absent.RoundsLeft()))
+		switch {
+		case absent.Critical():
+			heartbeat.Level = HealthError
+		case absent.AtRisk():
+			heartbeat.Level = HealthWarning
+		}
+	}
+	health.Items = append(health.Items, heartbeat)
+
+	// Participation key validity
+	key := HealthItem{Label: "Participation Key", Value: "None", Level: HealthError}
+	if expiry != nil {
+		key.Value = fmt.Sprintf("Valid until %s", format.Int(expiry.LastValid))
+		key.Detail = fmt.Sprintf("Expires in %s (%s).", format.Duration(expiry.Remaining()), expiry.Expires.Format("2006-01-02"))
+		thresholds := KeyExpiryWarnings()
+		warning := expiry.Warning(thresholds)
+		switch {
+		case expiry.Expired():
+			key.Level = HealthError
+			key.Detail = "The participation key has expired."
+		case warning > 0 && len(thresholds) > 0 && warning == thresholds[0]:
+			key.Level = HealthError
+		case warning > 0:
+			key.Level = HealthWarning
+		default:
+			key.Level = HealthOK
+		}
+	} else if account.Participation != nil {
+		key.Value = fmt.Sprintf("Valid until %s", format.Int(account.Participation.VoteLastValid))
+		key.Level = HealthWarning
+		key.Detail = "Expiry could not be estimated."
+	}
+	health.Items = append(health.Items, key)
+
+	// Indexer lag behind algod
+	lag := HealthItem{Label: "Indexer Lag", Value: "Unavailable", Level: HealthError}
+	if indexer != nil && status != nil {
+		rounds := max(status.LastRound-indexer.Round, 0)
+		lag.Value = fmt.Sprintf("%s rounds", format.Int(rounds))
+		lag.Detail = fmt.Sprintf("Indexer %s at round %s.", indexer.Version, format.Int(indexer.Round))
+		switch {
+		case !indexer.DBAvailable || rounds >= indexerErrorLag:
+			lag.Level = HealthError
+		case indexer.IsMigrating || rounds >= indexerWarningLag:
+			lag.Level = HealthWarning
+		default:
+			lag.Level = HealthOK
+		}
+		if indexer.Message != "" {
+			lag.Detail += " " + indexer.Message
+		}
+	}
+	health.Items = append(health.Items, lag)
+
+	return &health
+}
+
+// FetchHealth fetches the operational state of the account.
+func FetchHealth(account *Account) *Health {
+	if account == nil {
+		return nil
+	}
+
+	// A fresh status rather than the cached one, so a stalled node is detected
+	status := FetchStatus()
+	var expiry *KeyExpiry
+	if status != nil && account.Participation != nil {
+		expiry = NewKeyExpiry(account, status.LastRound, cachedAverageRoundTime(status.LastRound), time.Now())
+	}
+
+	return NewHealth(account, status, FetchIndexerHealth(), FetchAbsenteeism(account), expiry)
+}
+
+// Level returns the worst level of the health items.
+func (h *Health) Level() string {
+	level := HealthOK
+	for _, item := range h.Items {
+		switch item.Level {
+		case HealthError:
+			return HealthError
+		case HealthWarning:
+			level = HealthWarning
+		}
+	}
+	return level
+}
@@ -0,0 +1,282 @@
+package algo
+
+import (
+	"cmp"
+	"encoding/base32"
+	"math/bits"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+const (
+	// challengeInterval is the number of rounds between protocol challenges.
+	challengeInterval = 1000
+
+	// challengeGracePeriod is the number of rounds a challenged account has to heartbeat.
+	challengeGracePeriod = 200
+
+	// challengeBits is the number of leading bits of the challenge seed an address must match to be challenged.
+	challengeBits = 5
+
+	// heartbeatAlertFactor is how many usual intervals may pass without a heartbeat before alerting.
+	heartbeatAlertFactor = 3
+)
+
+// challengeSeeds holds the fetched seeds of challenge rounds.
+var challengeSeeds = struct {
+	sync.Mutex
+	seeds map[int64][]byte
+}{seeds: map[int64][]byte{}}
+
+// Heartbeat represents a heartbeat transaction for an account.
+type Heartbeat struct {
+	ID        string
+	Round     int64
+	Time      time.Time
+	Sender    string
+	Fee       int64
+	Challenge bool // Sent in response to a protocol challenge of the account
+}
+
+// HeartbeatHistory represents the heartbeats of an account.
+type HeartbeatHistory struct {
+	Heartbeats   []Heartbeat // Newest first
+	Round        int64
+	LastProposed int64
+	Unanswered   int64 // Latest challenge round of the account not answered yet, or 0 if none
+	Now          time.Time
+}
+
+// NewHeartbeatHistory creates a new HeartbeatHistory from the heartbeat transactions of the account.
+//
+// The first heartbeat confirmed within the grace period after a challenge
+// round is marked as the challenge response if the seed of that round, from
+// the given seeds by challenge round, challenged the account and the account
+// did not propose since the challenge round. The latest challenge of an online
+// account is unanswered while the account was not seen at or after its round.
+func NewHeartbeatHistory(account *Account, txs []TransactionDetail, seeds map[int64][]byte, now time.Time) *HeartbeatHistory {
+	history := HeartbeatHistory{Round: account.Round, LastProposed: account.LastProposed, Now: now}
+
+	for _, tx := range txs {
+		if tx.Type != "hb" {
+			continue
+		}
+		if tx.Heartbeat == nil || tx.Heartbeat.Address != account.Address {
+			continue
+		}
+		history.Heartbeats = append(history.Heartbeats, Heartbeat{
+			ID:     tx.ID,
+			Round:  tx.ConfirmedRound,
+			Time:   tx.Time(),
+			Sender: tx.Sender,
+			Fee:    tx.Fee,
+		})
+	}
+	slices.SortFunc(history.Heartbeats, func(a, b Heartbeat) int {
+		return cmp.Compare(b.Round, a.Round)
+	})
+
+	// Oldest first, so a later heartbeat in the same grace period is not a response
+	answered := map[int64]bool{}
+	for i := len(history.Heartbeats) - 1; i >= 0; i-- {
+		heartbeat := &history.Heartbeats[i]
+		challenge := challengeRound(heartbeat.Round)
+		if challenge == 0 || answered[challenge] {
+			continue
+		}
+		answered[challenge] = true
+		proposed := account.LastProposed >= challenge && account.LastProposed < heartbeat.Round
+		heartbeat.Challenge = !proposed && challenged(account.Address, seeds[challenge])
+	}
+
+	if challenge := latestChallenge(account); challenge > 0 && challenged(account.Address, seeds[challenge]) {
+		history.Unanswered = challenge
+	}
+
+	return &history
+}
+
+// latestChallenge returns the latest challenge round of the online account
+// if the account was not seen at or after it, or 0 if none.
+func latestChallenge(account *Account) int64 {
+	challenge := account.Round - account.Round%challengeInterval
+	if account.Status != "Online" || challenge == 0 {
+		return 0
+	}
+	if max(account.LastHeartbeat, account.LastProposed) >= challenge {
+		return 0
+	}
+	return challenge
+}
+
+// FetchHeartbeatHistory returns the heartbeat history of the account.
+func FetchHeartbeatHistory(account *Account) *HeartbeatHistory {
+	if account == nil {
+		return nil
+	}
+	transactions := FetchTransactions(account.Address)
+	if transactions == nil {
+		return nil
+	}
+
+	// Fetch the seed of each challenge round a heartbeat may have answered
+	seeds := map[int64][]byte{}
+	for _, tx := range transactions.Transactions {
+		if tx.Type != "hb" || tx.Heartbeat == nil || tx.Heartbeat.Address != account.Address {
+			continue
+		}
+		round := challengeRound(tx.ConfirmedRound)
+		if _, ok := seeds[round]; round == 0 || ok {
+			continue
+		}
+		seeds[round] = fetchChallengeSeed(round)
+	}
+	if round := latestChallenge(account); round > 0 {
+		if _, ok := seeds[round]; !ok {
+			seeds[round] = fetchChallengeSeed(round)
+		}
+	}
+
+	return NewHeartbeatHistory(account, transactions.Transactions, seeds, time.Now())
+}
+
+// fetchChallengeSeed returns the seed of the block at the challenge round, or nil if it could not be fetched.
+//
+// Seeds never change, so fetched seeds are kept for the life of the app.
+func fetchChallengeSeed(round int64) []byte {
+	challengeSeeds.Lock()
+	defer challengeSeeds.Unlock()
+
+	if seed, ok := challengeSeeds.seeds[round]; ok {
+		return seed
+	}
+	block := FetchBlock(round)
+	if block == nil || len(block.Block.Seed) == 0 {
+		return nil
+	}
+	challengeSeeds.seeds[round] = block.Block.Seed
+
+	return block.Block.Seed
+}
+
+// challengeRound returns the challenge round whose grace period contains the round, or 0 if none.
+func challengeRound(round int64) int64 {
+	challenge := round - round%challengeInterval
+	if challenge == 0 || round == challenge || round > challenge+challengeGracePeriod {
+		return 0
+	}
+	return challenge
+}
+
+// challenged returns true if the leading bits of the challenge seed match the public key of the address.
+//
+// This is the check of go-algorand's FindChallenge and Challenge.Failed.
+func challenged(address string, seed []byte) bool {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(address)
+	if err != nil {
+		return false
+	}
+	return bitsMatch(seed, key, challengeBits)
+}
+
+// bitsMatch returns true if the first n bits of a and b are equal.
+func bitsMatch(a, b []byte, n int) bool {
+	if n < 0 || n > len(a)*8 || n > len(b)*8 {
+		return false
+	}
+	for i := 0; i < n/8; i++ {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	remaining := n % 8
+	if remaining == 0 {
+		return true
+	}
+	return bits.LeadingZeros8(a[n/8]^b[n/8]) >= remaining
+}
+
+// Last returns the most recent heartbeat, or nil if there are none.
+func (h *HeartbeatHistory) Last() *Heartbeat {
+	if len(h.Heartbeats) == 0 {
+		return nil
+	}
+	return &h.Heartbeats[0]
+}
+
+// SinceLast returns the time since the most recent heartbeat.
+func (h *HeartbeatHistory) SinceLast() time.Duration {
+	if last := h.Last(); last != nil {
+		return h.Now.Sub(last.Time)
+	}
+	return 0
+}
+
+// Challenges returns the number of heartbeats that answered a protocol challenge.
+func (h *HeartbeatHistory) Challenges() int {
+	var challenges int
+	for _, heartbeat := range h.Heartbeats {
+		if heartbeat.Challenge {
+			challenges++
+		}
+	}
+	return challenges
+}
+
+// MissedChallenge returns true if the grace period of the unanswered challenge has passed.
+func (h *HeartbeatHistory) MissedChallenge() bool {
+	return h.Unanswered > 0 && h.Round > h.Unanswered+challengeGracePeriod
+}
+
+// UsualInterval returns the median time between heartbeats, or 0 if there are fewer than two.
+func (h *HeartbeatHistory) UsualInterval() time.Duration {
+	if len(h.Heartbeats) < 2 {
+		return 0
+	}
+	intervals := make([]time.Duration, 0, len(h.Heartbeats)-1)
+	for i := 1; i < len(h.Heartbeats); i++ {
+		intervals = append(intervals, h.Heartbeats[i-1].Time.Sub(h.Heartbeats[i].Time))
+	}
+	slices.Sort(intervals)
+	return intervals[len(intervals)/2]
+}
+
+// AlertAfter returns the time without a heartbeat after which to alert, or 0 if unknown.
+//
+// Uses the hours selected in the app, otherwise a multiple of the usual interval.
+func (h *HeartbeatHistory) AlertAfter() time.Duration {
+	if hours := app.CurrentApp().HeartbeatAlertHours(); hours > 0 {
+		return time.Duration(hours * float64(time.Hour))
+	}
+	return h.UsualInterval() * heartbeatAlertFactor
+}
+
+// Overdue returns true if heartbeats stopped arriving as expected.
+//
+// A proposal since the last heartbeat shows the account is online, so no heartbeat is needed.
+func (h *HeartbeatHistory) Overdue() bool {
+	last := h.Last()
+	alertAfter := h.AlertAfter()
+	if last == nil || alertAfter <= 0 || h.LastProposed > last.Round {
+		return false
+	}
+	return h.SinceLast() > alertAfter
+}
+
+// ByDay returns the number of heartbeats on each of the given number of days up to today, oldest first.
+func (h *HeartbeatHistory) ByDay(days int) []float64 {
+	counts := make([]float64, days)
+	today := time.Date(h.Now.Year(), h.Now.Month(), h.Now.Day(), 0, 0, 0, 0, h.Now.Location())
+	for _, heartbeat := range h.Heartbeats {
+		t := heartbeat.Time.In(h.Now.Location())
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		index := days - 1 - int(today.Sub(day).Hours()/24+0.5)
+		if index >= 0 && index < days {
+			counts[index]++
+		}
+	}
+	return counts
+}
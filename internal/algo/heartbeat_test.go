@@ -0,0 +1,196 @@
+package algo
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// addressWithKey returns an address whose public key starts with the given bytes.
+func addressWithKey(prefix ...byte) string {
+	key := make([]byte, 36)
+	copy(key, prefix)
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(key)
+}
+
+func TestChallengeRound(t *testing.T) {
+	tests := []struct {
+		round int64
+		want  int64
+	}{
+		{500, 0},
+		{1000, 0},
+		{1001, 1000},
+		{1200, 1000},
+		{1201, 0},
+		{1999, 0},
+		{52000, 0},
+		{52150, 52000},
+	}
+	for _, tt := range tests {
+		if got := challengeRound(tt.round); got != tt.want {
+			t.Errorf("challengeRound(%d) = %d, want %d", tt.round, got, tt.want)
+		}
+	}
+}
+
+func TestBitsMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []byte
+		n    int
+		want bool
+	}{
+		{"equal", []byte{0b10101000}, []byte{0b10101000}, 5, true},
+		{"differ after bits", []byte{0b10101000}, []byte{0b10101111}, 5, true},
+		{"differ in last bit", []byte{0b10101000}, []byte{0b10100000}, 5, false},
+		{"differ in first bit", []byte{0b10101000}, []byte{0b00101000}, 5, false},
+		{"whole bytes", []byte{0xab, 0xcd}, []byte{0xab, 0xcd}, 16, true},
+		{"across bytes", []byte{0xab, 0xc0}, []byte{0xab, 0xff}, 10, true},
+		{"across bytes differ", []byte{0xab, 0x00}, []byte{0xab, 0xff}, 10, false},
+		{"no bits", []byte{0x00}, []byte{0xff}, 0, true},
+		{"too many bits", []byte{0x00}, []byte{0x00}, 9, false},
+		{"empty seed", nil, []byte{0x00}, 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bitsMatch(tt.a, tt.b, tt.n); got != tt.want {
+				t.Errorf("bitsMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChallenged(t *testing.T) {
+	address := addressWithKey(0b10101000)
+	tests := []struct {
+		name string
+		seed []byte
+		want bool
+	}{
+		{"matching bits", []byte{0b10101111, 0xff}, true},
+		{"other bits", []byte{0b10100111, 0xff}, false},
+		{"no seed", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := challenged(address, tt.seed); got != tt.want {
+				t.Errorf("challenged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if challenged("not an address", []byte{0b10101000}) {
+		t.Error("challenged() = true for an invalid address")
+	}
+}
+
+func TestNewHeartbeatHistoryChallenges(t *testing.T) {
+	address := addressWithKey(0b10101000)
+	heartbeat := func(id string, round int64) TransactionDetail {
+		return TransactionDetail{
+			ID:             id,
+			Type:           "hb",
+			ConfirmedRound: round,
+			Heartbeat:      &HeartbeatTransaction{Address: address},
+		}
+	}
+	txs := []TransactionDetail{
+		heartbeat("matching seed", 1050),
+		heartbeat("other seed", 2050),
+		heartbeat("outside window", 1500), // Would match the seed of round 1000 if the window were ignored
+		heartbeat("no seed", 3050),
+	}
+	seeds := map[int64][]byte{
+		1000: {0b10101000},
+		2000: {0b01010000},
+	}
+
+	history := NewHeartbeatHistory(&Account{Address: address}, txs, seeds, time.Now())
+
+	want := map[string]bool{"matching seed": true}
+	for _, heartbeat := range history.Heartbeats {
+		if heartbeat.Challenge != want[heartbeat.ID] {
+			t.Errorf("%s: Challenge = %v, want %v", heartbeat.ID, heartbeat.Challenge, want[heartbeat.ID])
+		}
+	}
+	if got := history.Challenges(); got != 1 {
+		t.Errorf("Challenges() = %d, want 1", got)
+	}
+}
+
+func TestNewHeartbeatHistoryResponded(t *testing.T) {
+	address := addressWithKey(0b10101000)
+	heartbeat := func(id string, round int64) TransactionDetail {
+		return TransactionDetail{
+			ID:             id,
+			Type:           "hb",
+			ConfirmedRound: round,
+			Heartbeat:      &HeartbeatTransaction{Address: address},
+		}
+	}
+	seeds := map[int64][]byte{1000: {0b10101000}, 2000: {0b10101000}}
+
+	tests := []struct {
+		name       string
+		account    Account
+		txs        []TransactionDetail
+		challenges int
+		unanswered int64
+		missed     bool
+	}{
+		{
+			name:       "answered by heartbeat",
+			account:    Account{Round: 1500, LastHeartbeat: 1050},
+			txs:        []TransactionDetail{heartbeat("first", 1050), heartbeat("second", 1100)},
+			challenges: 1,
+		},
+		{
+			name:       "answered by proposal",
+			account:    Account{Round: 1500, LastHeartbeat: 1100, LastProposed: 1020},
+			txs:        []TransactionDetail{heartbeat("after proposal", 1100)},
+			challenges: 0,
+		},
+		{
+			name:    "proposed at the challenge round",
+			account: Account{Round: 2300, LastHeartbeat: 500, LastProposed: 2000},
+		},
+		{
+			name:       "waiting for a heartbeat",
+			account:    Account{Round: 2150, LastHeartbeat: 1050, LastProposed: 1999},
+			txs:        []TransactionDetail{heartbeat("old", 1050)},
+			challenges: 1,
+			unanswered: 2000,
+		},
+		{
+			name:       "missed",
+			account:    Account{Round: 2300, LastHeartbeat: 1050},
+			txs:        []TransactionDetail{heartbeat("old", 1050)},
+			challenges: 1,
+			unanswered: 2000,
+			missed:     true,
+		},
+		{
+			name:    "offline",
+			account: Account{Status: "Offline", Round: 2300},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := tt.account
+			account.Address = address
+			if account.Status == "" {
+				account.Status = "Online"
+			}
+			history := NewHeartbeatHistory(&account, tt.txs, seeds, time.Now())
+			if got := history.Challenges(); got != tt.challenges {
+				t.Errorf("Challenges() = %d, want %d", got, tt.challenges)
+			}
+			if history.Unanswered != tt.unanswered {
+				t.Errorf("Unanswered = %d, want %d", history.Unanswered, tt.unanswered)
+			}
+			if got := history.MissedChallenge(); got != tt.missed {
+				t.Errorf("MissedChallenge() = %v, want %v", got, tt.missed)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package algo
+
+import "time"
+
+// HourlyDistribution represents how wins and payouts spread across the hours of the day and week.
+type HourlyDistribution struct {
+	Wins     [24]int64
+	Payouts  [24]float64
+	Weekdays []time.Weekday // Rows of the matrix starting at the first day of the week
+	Matrix   [7][24]int64   // Wins by weekday row and hour
+	Total    int64
+}
+
+// NewHourlyDistribution creates a new HourlyDistribution from the block timestamps in range.
+//
+// Hours are in the local timezone.
+func NewHourlyDistribution(r *Rewards) *HourlyDistribution {
+	distribution := HourlyDistribution{Weekdays: CurrentCalendar().Weekdays()}
+
+	// Row of each weekday in the matrix
+	rows := make(map[time.Weekday]int, 7)
+	for i, day := range distribution.Weekdays {
+		rows[day] = i
+	}
+
+	for _, block := range r.Blocks {
+		t := block.Time()
+		if !r.InRange(t.Format("2006-01-02")) {
+			continue
+		}
+		hour := t.Hour()
+		distribution.Wins[hour]++
+		distribution.Payouts[hour] += block.AlgoPayout()
+		distribution.Matrix[rows[t.Weekday()]][hour]++
+		distribution.Total++
+	}
+
+	return &distribution
+}
+
+// ExpectedPerHour returns the wins of each hour if they were spread evenly.
+func (d *HourlyDistribution) ExpectedPerHour() float64 {
+	return float64(d.Total) / 24
+}
+
+// MaxCell returns the most wins in a cell of the matrix.
+func (d *HourlyDistribution) MaxCell() int64 {
+	var most int64
+	for _, row := range d.Matrix {
+		for _, wins := range row {
+			most = max(most, wins)
+		}
+	}
+	return most
+}
+
+// BusiestHour returns the hour with the most wins.
+func (d *HourlyDistribution) BusiestHour() int {
+	var busiest int
+	for hour, wins := range d.Wins {
+		if wins > d.Wins[busiest] {
+			busiest = hour
+		}
+	}
+	return busiest
+}
+
+// QuietestHour returns the hour with the fewest wins.
+func (d *HourlyDistribution) QuietestHour() int {
+	var quietest int
+	for hour, wins := range d.Wins {
+		if wins < d.Wins[quietest] {
+			quietest = hour
+		}
+	}
+	return quietest
+}
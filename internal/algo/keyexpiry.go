@@ -0,0 +1,192 @@
+package algo
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/nodely"
+)
+
+// roundTimeCacheDuration is how long a measured round time is reused.
+const roundTimeCacheDuration = time.Hour
+
+// roundTimeCache holds the last measured round time.
+var roundTimeCache struct {
+	sync.Mutex
+	roundTime time.Duration
+	fetched   time.Time
+}
+
+// statusCacheDuration is how long a fetched node status is reused.
+const statusCacheDuration = 30 * time.Second
+
+// statusCache holds the last fetched node status.
+var statusCache struct {
+	sync.Mutex
+	status  *NodeStatus
+	fetched time.Time
+}
+
+// NodeStatus represents the status of the node.
+type NodeStatus struct {
+	LastRound          int64 `json:"last-round"`
+	TimeSinceLastRound int64 `json:"time-since-last-round"` // Nanoseconds
+}
+
+// FetchStatus fetches the node status from the nodely api.
+//
+// Docs: https://nodely.io/swagger/index.html?url=/swagger/api/4160/algod.oas3.yml#/public/GetStatus
+func FetchStatus() *NodeStatus {
+	client := nodely.NewClient()
+
+	var status NodeStatus
+	err := client.Get("/v2/status", &status)
+	if err != nil || status.LastRound == 0 {
+		return nil
+	}
+
+	return &status
+}
+
+// KeyExpiry represents when the participation key of an account expires.
+type KeyExpiry struct {
+	LastValid    int64
+	CurrentRound int64
+	RoundTime    time.Duration
+	Expires      time.Time
+	Now          time.Time
+}
+
+// NewKeyExpiry estimates the expiry of the participation key from the average round time.
+//
+// Returns nil if the account has no participation key.
+func NewKeyExpiry(account *Account, currentRound int64, roundTime time.Duration, now time.Time) *KeyExpiry {
+	if account == nil || account.Participation == nil || account.Participation.VoteLastValid == 0 {
+		return nil
+	}
+
+	expiry := KeyExpiry{
+		LastValid:    account.Participation.VoteLastValid,
+		CurrentRound: currentRound,
+		RoundTime:    roundTime,
+		Now:          now,
+	}
+	expiry.Expires = now.Add(time.Duration(expiry.RoundsLeft()) * roundTime)
+
+	return &expiry
+}
+
+// RoundsLeft returns the rounds left until the key expires.
+func (k *KeyExpiry) RoundsLeft() int64 {
+	return max(k.LastValid-k.CurrentRound, 0)
+}
+
+// Remaining returns the estimated time left until the key expires.
+func (k *KeyExpiry) Remaining() time.Duration {
+	return k.Expires.Sub(k.Now)
+}
+
+// Expired returns true if the key is past its last valid round.
+func (k *KeyExpiry) Expired() bool {
+	return k.CurrentRound > k.LastValid
+}
+
+// Warning returns the smallest threshold in days the key expires within, or 0 if none.
+//
+// An expired key returns the smallest threshold.
+func (k *KeyExpiry) Warning(thresholds []int) int {
+	var warning int
+	for _, days := range thresholds {
+		if k.Remaining() > time.Duration(days)*24*time.Hour {
+			continue
+		}
+		if warning == 0 || days < warning {
+			warning = days
+		}
+	}
+	return warning
+}
+
+// ParseKeyExpiryWarnings parses comma separated days such as "14,3,1" into thresholds.
+func ParseKeyExpiryWarnings(value string) ([]int, error) {
+	var thresholds []int
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		days, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		if days <= 0 {
+			return nil, errors.New("days must be greater than zero")
+		}
+		thresholds = append(thresholds, days)
+	}
+	slices.Sort(thresholds)
+	return slices.Compact(thresholds), nil
+}
+
+// KeyExpiryWarnings returns the thresholds in days selected in the app.
+func KeyExpiryWarnings() []int {
+	thresholds, err := ParseKeyExpiryWarnings(app.CurrentApp().KeyExpiryWarnings())
+	if err != nil {
+		return nil
+	}
+	return thresholds
+}
+
+// cachedAverageRoundTime returns the average round time, measured at most once an hour.
+func cachedAverageRoundTime(round int64) time.Duration {
+	roundTimeCache.Lock()
+	defer roundTimeCache.Unlock()
+
+	if roundTimeCache.roundTime > 0 && time.Since(roundTimeCache.fetched) < roundTimeCacheDuration {
+		return roundTimeCache.roundTime
+	}
+
+	roundTime := FetchAverageRoundTime(round)
+	if roundTime != DefaultRoundTime {
+		roundTimeCache.roundTime = roundTime
+		roundTimeCache.fetched = time.Now()
+	}
+
+	return roundTime
+}
+
+// cachedStatus returns the node status, fetched at most every 30 seconds so
+// the header and the views rendered with it share one request.
+func cachedStatus() *NodeStatus {
+	statusCache.Lock()
+	defer statusCache.Unlock()
+
+	if statusCache.status != nil && time.Since(statusCache.fetched) < statusCacheDuration {
+		return statusCache.status
+	}
+
+	status := FetchStatus()
+	if status != nil {
+		statusCache.status = status
+		statusCache.fetched = time.Now()
+	}
+
+	return status
+}
+
+// FetchKeyExpiry returns the expiry of the participation key of the account.
+func FetchKeyExpiry(account *Account) *KeyExpiry {
+	if account == nil || account.Participation == nil {
+		return nil
+	}
+	status := cachedStatus()
+	if status == nil {
+		return nil
+	}
+	return NewKeyExpiry(account, status.LastRound, cachedAverageRoundTime(status.LastRound), time.Now())
+}
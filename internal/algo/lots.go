@@ -0,0 +1,348 @@
+package algo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/price"
+)
+
+// LotMethods are the available methods of choosing the lots a disposal consumes.
+var LotMethods = []string{"fifo", "lifo", "hifo"}
+
+// Lot represents ALGO acquired from a proposer payout.
+//
+// Amounts are in microalgos so matching disposals against lots is exact.
+type Lot struct {
+	Round        int64
+	Acquired     time.Time
+	Amount       int64
+	Remaining    int64
+	Price        float64 // Fair market value of one ALGO at receipt
+	PriceMissing bool    // No price for the day of receipt, so the cost basis is not known
+}
+
+// AlgoAmount returns the amount of the lot in Algos.
+func (l Lot) AlgoAmount() float64 {
+	return float64(l.Amount) / 1e6
+}
+
+// AlgoRemaining returns the remaining amount of the lot in Algos.
+func (l Lot) AlgoRemaining() float64 {
+	return float64(l.Remaining) / 1e6
+}
+
+// CostBasis returns the cost basis of the remaining amount of the lot.
+func (l Lot) CostBasis() float64 {
+	return l.AlgoRemaining() * l.Price
+}
+
+// LotMatch represents the part of a lot consumed by a disposal.
+type LotMatch struct {
+	Round        int64
+	Acquired     time.Time
+	Amount       int64 // Microalgos
+	CostBasis    float64
+	PriceMissing bool // No price for the day the lot was acquired, so the cost basis is not known
+}
+
+// AlgoAmount returns the amount consumed from the lot in Algos.
+func (m LotMatch) AlgoAmount() float64 {
+	return float64(m.Amount) / 1e6
+}
+
+// Disposal represents ALGO sent from the account.
+type Disposal struct {
+	ID           string
+	Time         time.Time
+	Round        int64
+	Amount       int64 // Microalgos matched against lots
+	Unmatched    int64 // Microalgos not covered by reward lots
+	Price        float64
+	Proceeds     float64
+	CostBasis    float64
+	Gain         float64
+	Matches      []LotMatch
+	PriceMissing bool // No price for the day of the disposal, so the proceeds are not known
+}
+
+// AlgoAmount returns the amount matched against lots in Algos.
+func (d Disposal) AlgoAmount() float64 {
+	return float64(d.Amount) / 1e6
+}
+
+// GainMissing returns true if the price of the disposal or of a matched lot is missing, so the gain is not known.
+func (d Disposal) GainMissing() bool {
+	if d.PriceMissing {
+		return true
+	}
+	for _, match := range d.Matches {
+		if match.PriceMissing {
+			return true
+		}
+	}
+	return false
+}
+
+// LotReport represents the reward lots of an account and the disposals matched against them.
+type LotReport struct {
+	Method     string
+	Currency   string
+	Price      float64 // Current price of one ALGO
+	Lots       []Lot   // Lots with a remaining amount, oldest first
+	Disposals  []Disposal
+	Realized   float64
+	Unrealized float64
+	Remaining  int64 // Microalgos in the open lots
+	Unmatched  int64 // Microalgos disposed of beyond the reward lots
+	Missing    int   // Lots and disposals without a price, left out of the gains
+	NoPrice    bool  // No current price, so unrealized gains are not known
+}
+
+// AlgoRemaining returns the amount in the open lots in Algos.
+func (r *LotReport) AlgoRemaining() float64 {
+	return float64(r.Remaining) / 1e6
+}
+
+// AlgoUnmatched returns the amount disposed of beyond the reward lots in Algos.
+func (r *LotReport) AlgoUnmatched() float64 {
+	return float64(r.Unmatched) / 1e6
+}
+
+// lotEvent represents an acquisition or a disposal in time order.
+type lotEvent struct {
+	time     time.Time
+	round    int64
+	block    *BlockHeader
+	disposal *TransactionDetail
+}
+
+// NewLotReport creates a new LotReport with the given method.
+//
+// Every proposer payout creates a lot valued at the price of the UTC day it was
+// received. Payments sent by the address to other accounts and the fees of
+// every transaction sent by the address, such as key registrations and
+// heartbeats, dispose of lots in the order of the method: oldest first (fifo),
+// newest first (lifo) or highest price first (hifo). Payments to the address
+// itself only dispose of their fee. Amounts beyond the open reward lots are
+// unmatched, as they come from ALGO that was not earned as rewards. Lots and
+// disposals without a price are counted as missing and left out of the gains
+// rather than valued at zero.
+func NewLotReport(address string, blocks []BlockHeader, txs []TransactionDetail, prices price.History, method string, now time.Time) *LotReport {
+	report := LotReport{Method: method}
+	currentPrice, ok := prices.At(now)
+	report.Price, report.NoPrice = currentPrice, !ok
+
+	// Order the acquisitions and disposals by time
+	var events []lotEvent
+	for i := range blocks {
+		if blocks[i].ProposerPayout > 0 {
+			events = append(events, lotEvent{time: blocks[i].Time(), round: blocks[i].Round, block: &blocks[i]})
+		}
+	}
+	for i := range txs {
+		if txs[i].Sender == address {
+			events = append(events, lotEvent{time: txs[i].Time(), round: txs[i].ConfirmedRound, disposal: &txs[i]})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].round < events[j].round
+	})
+
+	var lots []Lot
+	for _, event := range events {
+		if event.block != nil {
+			lotPrice, ok := prices.At(event.time)
+			lots = append(lots, Lot{
+				Round:        event.block.Round,
+				Acquired:     event.time,
+				Amount:       event.block.ProposerPayout,
+				Remaining:    event.block.ProposerPayout,
+				Price:        lotPrice,
+				PriceMissing: !ok,
+			})
+			if !ok {
+				report.Missing++
+			}
+			continue
+		}
+
+		tx := event.disposal
+		amount := tx.Fee
+		if tx.Payment != nil && tx.Payment.Receiver != address {
+			amount += tx.Payment.Amount + tx.Payment.CloseAmount
+		}
+		disposalPrice, ok := prices.At(event.time)
+		disposal := Disposal{
+			ID:           tx.ID,
+			Time:         event.time,
+			Round:        tx.ConfirmedRound,
+			Price:        disposalPrice,
+			PriceMissing: !ok,
+		}
+
+		for _, i := range lotOrder(lots, method) {
+			if amount <= 0 {
+				break
+			}
+			used := min(amount, lots[i].Remaining)
+			lots[i].Remaining -= used
+			amount -= used
+
+			match := LotMatch{Round: lots[i].Round, Acquired: lots[i].Acquired, Amount: used, CostBasis: float64(used) / 1e6 * lots[i].Price, PriceMissing: lots[i].PriceMissing}
+			disposal.Matches = append(disposal.Matches, match)
+			disposal.Amount += used
+			disposal.CostBasis += match.CostBasis
+		}
+		disposal.Unmatched = amount
+		disposal.Proceeds = disposal.AlgoAmount() * disposal.Price
+		disposal.Gain = disposal.Proceeds - disposal.CostBasis
+		if disposal.Amount > 0 {
+			report.Disposals = append(report.Disposals, disposal)
+			if disposal.GainMissing() {
+				report.Missing++
+			} else {
+				report.Realized += disposal.Gain
+			}
+		}
+		report.Unmatched += disposal.Unmatched
+	}
+
+	// Keep the open lots
+	for _, lot := range lots {
+		if lot.Remaining > 0 {
+			report.Lots = append(report.Lots, lot)
+			report.Remaining += lot.Remaining
+			if !lot.PriceMissing && !report.NoPrice {
+				report.Unrealized += lot.AlgoRemaining()*report.Price - lot.CostBasis()
+			}
+		}
+	}
+
+	return &report
+}
+
+// lotOrder returns the indexes of the open lots in the order the method consumes them.
+func lotOrder(lots []Lot, method string) []int {
+	var order []int
+	for i, lot := range lots {
+		if lot.Remaining > 0 {
+			order = append(order, i)
+		}
+	}
+
+	switch method {
+	case "lifo":
+		sort.SliceStable(order, func(i, j int) bool {
+			return lots[order[i]].Round > lots[order[j]].Round
+		})
+	case "hifo":
+		sort.SliceStable(order, func(i, j int) bool {
+			return lots[order[i]].Price > lots[order[j]].Price
+		})
+	default:
+		// Lots are created oldest first
+	}
+
+	return order
+}
+
+// FetchLotReport returns the lot report of the current address with the selected method.
+func FetchLotReport(address string) *LotReport {
+	rewards := FetchRewards(address)
+	transactions := FetchTransactions(address)
+	if rewards == nil || transactions == nil {
+		return nil
+	}
+
+	method := app.CurrentApp().LotMethod()
+	if method == "" {
+		method = LotMethods[0]
+	}
+
+	prices := price.FetchHistory()
+	report := NewLotReport(address, rewards.Blocks, transactions.Transactions, prices, method, time.Now())
+	if prices != nil {
+		report.Currency = price.Currency()
+	}
+	return report
+}
+
+// Data returns the data for the disposals and open lots.
+//
+// Every row has the columns of the header. Prices and gains that are not
+// known are left blank or marked as missing in their row, and their count is
+// shown by the cost basis view.
+func (r *LotReport) Data() [][]string {
+	var data = [][]string{{"Type", "Date", "Round", "Transaction", "Amount", "Price", "Proceeds", "Cost Basis", "Gain", "Lots"}}
+
+	// Append disposals to data
+	for _, disposal := range r.Disposals {
+		var lots []string
+		for _, match := range disposal.Matches {
+			lots = append(lots, fmt.Sprintf("%d:%.6f", match.Round, match.AlgoAmount()))
+		}
+		price, proceeds, gain := fmt.Sprintf("%.6f", disposal.Price), fmt.Sprintf("%.2f", disposal.Proceeds), fmt.Sprintf("%.2f", disposal.Gain)
+		if disposal.PriceMissing {
+			price, proceeds = "missing", ""
+		}
+		if disposal.GainMissing() {
+			gain = ""
+		}
+		data = append(data, []string{
+			"Disposal",
+			disposal.Time.Format("2006-01-02"),
+			fmt.Sprintf("%d", disposal.Round),
+			disposal.ID,
+			fmt.Sprintf("%.6f", disposal.AlgoAmount()),
+			price,
+			proceeds,
+			fmt.Sprintf("%.2f", disposal.CostBasis),
+			gain,
+			strings.Join(lots, " "),
+		})
+	}
+
+	// Append open lots to data
+	for _, lot := range r.Lots {
+		price, costBasis, gain := fmt.Sprintf("%.6f", lot.Price), fmt.Sprintf("%.2f", lot.CostBasis()), fmt.Sprintf("%.2f", lot.AlgoRemaining()*r.Price-lot.CostBasis())
+		if lot.PriceMissing {
+			price, costBasis, gain = "missing", "", ""
+		} else if r.NoPrice {
+			gain = ""
+		}
+		data = append(data, []string{
+			"Open Lot",
+			lot.Acquired.Format("2006-01-02"),
+			fmt.Sprintf("%d", lot.Round),
+			"",
+			fmt.Sprintf("%.6f", lot.AlgoRemaining()),
+			price,
+			"",
+			costBasis,
+			gain,
+			"",
+		})
+	}
+	return data
+}
+
+// ExportLotReport exports the lot report to a CSV file.
+func ExportLotReport(r *LotReport, writeCloser fyne.URIWriteCloser) {
+	// Create a new CSV writer
+	writer := csv.NewWriter(writeCloser)
+	defer writer.Flush()
+
+	// Write the CSV rows
+	for _, row := range r.Data() {
+		err := writer.Write(row)
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package algo
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/price"
+)
+
+// lotDay returns noon UTC on the given day of October 2026, the day its price is keyed by.
+func lotDay(day int) time.Time {
+	return time.Date(2026, 10, day, 12, 0, 0, 0, time.UTC)
+}
+
+// sendAt returns a payment of the amount in microalgos from A to the receiver.
+func sendAt(round int64, day int, amount, fee int64, receiver string) TransactionDetail {
+	return TransactionDetail{
+		ID:             fmt.Sprintf("tx%d", round),
+		Type:           "pay",
+		Sender:         "A",
+		Fee:            fee,
+		ConfirmedRound: round,
+		Timestamp:      lotDay(day).Unix(),
+		Payment:        &PaymentTransaction{Amount: amount, Receiver: receiver},
+	}
+}
+
+func TestNewLotReport(t *testing.T) {
+	// Four lots of 10 Algo, the second and fourth at the same price
+	blocks := []BlockHeader{
+		blockAt(1, lotDay(1)),
+		blockAt(2, lotDay(2)),
+		blockAt(3, lotDay(3)),
+		blockAt(4, lotDay(4)),
+	}
+	prices := price.History{"2026-10-01": 1, "2026-10-02": 3, "2026-10-03": 2, "2026-10-04": 3, "2026-10-10": 5}
+
+	tests := []struct {
+		name      string
+		method    string
+		txs       []TransactionDetail
+		matches   [][]string // Round and amount of the lots matched by each disposal
+		unmatched float64
+		remaining float64
+		realized  float64
+	}{
+		{
+			name:      "fifo partial lot",
+			method:    "fifo",
+			txs:       []TransactionDetail{sendAt(10, 10, 15e6, 0, "B")},
+			matches:   [][]string{{"1:10", "2:5"}},
+			remaining: 25,
+			realized:  75 - 25,
+		},
+		{
+			name:      "lifo partial lot",
+			method:    "lifo",
+			txs:       []TransactionDetail{sendAt(10, 10, 15e6, 0, "B")},
+			matches:   [][]string{{"4:10", "3:5"}},
+			remaining: 25,
+			realized:  75 - 40,
+		},
+		{
+			name:      "hifo ties keep the oldest lot first",
+			method:    "hifo",
+			txs:       []TransactionDetail{sendAt(10, 10, 15e6, 0, "B")},
+			matches:   [][]string{{"2:10", "4:5"}},
+			remaining: 25,
+			realized:  75 - 45,
+		},
+		{
+			name:      "unknown method is fifo",
+			method:    "",
+			txs:       []TransactionDetail{sendAt(10, 10, 15e6, 0, "B")},
+			matches:   [][]string{{"1:10", "2:5"}},
+			remaining: 25,
+			realized:  75 - 25,
+		},
+		{
+			name:      "lot consumed by two disposals",
+			method:    "fifo",
+			txs:       []TransactionDetail{sendAt(10, 10, 5e6, 0, "B"), sendAt(11, 10, 10e6, 0, "B")},
+			matches:   [][]string{{"1:5"}, {"1:5", "2:5"}},
+			remaining: 25,
+			realized:  75 - 25,
+		},
+		{
+			name:      "larger than the open lots",
+			method:    "hifo",
+			txs:       []TransactionDetail{sendAt(10, 10, 50e6, 0, "B")},
+			matches:   [][]string{{"2:10", "4:10", "3:10", "1:10"}},
+			unmatched: 10,
+			realized:  200 - 90,
+		},
+		{
+			name:      "before any lot",
+			method:    "fifo",
+			txs:       []TransactionDetail{sendAt(0, 1, 5e6, 0, "B")},
+			unmatched: 5,
+			remaining: 40,
+		},
+		{
+			name:      "self payment disposes of the fee only",
+			method:    "fifo",
+			txs:       []TransactionDetail{sendAt(10, 10, 100e6, 2000, "A")},
+			matches:   [][]string{{"1:0.002"}},
+			remaining: 40 - 0.002,
+			realized:  0.002 * (5 - 1),
+		},
+		{
+			name:      "fee added to the payment",
+			method:    "fifo",
+			txs:       []TransactionDetail{sendAt(10, 10, 10e6, 1000, "B")},
+			matches:   [][]string{{"1:10", "2:0.001"}},
+			remaining: 30 - 0.001,
+			realized:  10.001*5 - 10 - 0.003,
+		},
+		{
+			name:      "received payments are ignored",
+			method:    "fifo",
+			txs:       []TransactionDetail{{ID: "in", Sender: "B", ConfirmedRound: 10, Payment: &PaymentTransaction{Amount: 5e6, Receiver: "A"}}},
+			remaining: 40,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewLotReport("A", blocks, tt.txs, prices, tt.method, lotDay(10))
+
+			var matches [][]string
+			for _, disposal := range report.Disposals {
+				var lots []string
+				for _, match := range disposal.Matches {
+					lots = append(lots, fmt.Sprintf("%d:%g", match.Round, match.AlgoAmount()))
+				}
+				matches = append(matches, lots)
+			}
+			if !slices.EqualFunc(matches, tt.matches, slices.Equal) {
+				t.Errorf("matches = %v, want %v", matches, tt.matches)
+			}
+			if !approx(report.AlgoUnmatched(), tt.unmatched) {
+				t.Errorf("AlgoUnmatched() = %g, want %g", report.AlgoUnmatched(), tt.unmatched)
+			}
+			if !approx(report.AlgoRemaining(), tt.remaining) {
+				t.Errorf("AlgoRemaining() = %g, want %g", report.AlgoRemaining(), tt.remaining)
+			}
+			if !approx(report.Realized, tt.realized) {
+				t.Errorf("Realized = %g, want %g", report.Realized, tt.realized)
+			}
+			if report.Missing != 0 {
+				t.Errorf("Missing = %d, want 0", report.Missing)
+			}
+		})
+	}
+}
+
+func TestNewLotReportUnrealized(t *testing.T) {
+	blocks := []BlockHeader{blockAt(1, lotDay(1)), blockAt(2, lotDay(2))}
+	prices := price.History{"2026-10-01": 1, "2026-10-02": 3, "2026-10-10": 5}
+
+	report := NewLotReport("A", blocks, []TransactionDetail{sendAt(10, 10, 5e6, 0, "B")}, prices, "fifo", lotDay(10))
+	if len(report.Lots) != 2 || report.Lots[0].Remaining != 5e6 || report.Lots[1].Remaining != 10e6 {
+		t.Fatalf("Lots = %+v, want 5 and 10 Algo remaining", report.Lots)
+	}
+	// 15 Algo at 5 less the cost basis of 5 at 1 and 10 at 3
+	if !approx(report.Unrealized, 75-35) {
+		t.Errorf("Unrealized = %g, want %g", report.Unrealized, 75.0-35)
+	}
+}
+
+func TestNewLotReportMissingPrices(t *testing.T) {
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)), // No price in the week before
+		blockAt(2, lotDay(2)),
+	}
+	prices := price.History{"2026-10-02": 3, "2026-10-10": 5}
+
+	report := NewLotReport("A", blocks, []TransactionDetail{sendAt(10, 10, 15e6, 0, "B")}, prices, "fifo", lotDay(30))
+	if len(report.Disposals) != 1 || !report.Disposals[0].GainMissing() {
+		t.Fatalf("Disposals = %+v, want a disposal without a gain", report.Disposals)
+	}
+	if report.Missing != 2 {
+		t.Errorf("Missing = %d, want the lot and the disposal", report.Missing)
+	}
+	if report.Realized != 0 {
+		t.Errorf("Realized = %g, want 0", report.Realized)
+	}
+	if !report.NoPrice || report.Unrealized != 0 {
+		t.Errorf("NoPrice = %v and Unrealized = %g, want no current price", report.NoPrice, report.Unrealized)
+	}
+}
+
+func TestLotReportDataColumns(t *testing.T) {
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)), // No price
+		blockAt(2, lotDay(2)),
+	}
+	prices := price.History{"2026-10-02": 3}
+
+	report := NewLotReport("A", blocks, []TransactionDetail{sendAt(10, 10, 15e6, 0, "B")}, prices, "fifo", lotDay(30))
+	if report.Missing == 0 || !report.NoPrice {
+		t.Fatalf("Missing = %d and NoPrice = %v, want missing prices", report.Missing, report.NoPrice)
+	}
+
+	data := report.Data()
+	// Header, the disposal and the rest of the second lot
+	if len(data) != 3 {
+		t.Fatalf("len(Data()) = %d, want 3", len(data))
+	}
+	for i, row := range data {
+		if len(row) != len(data[0]) {
+			t.Errorf("row %d = %v, want %d columns", i, row, len(data[0]))
+		}
+	}
+}
+
+func TestNewLotReportManySmallDisposals(t *testing.T) {
+	blocks := []BlockHeader{blockAt(1, lotDay(1)), blockAt(2, lotDay(2))}
+	prices := price.History{"2026-10-01": 0.1, "2026-10-02": 0.3}
+
+	// Fees of 0.001 Algo add up to exactly the first lot and a part of the second
+	var txs []TransactionDetail
+	for i := int64(0); i < 15_000; i++ {
+		txs = append(txs, sendAt(10+i, 10, 0, 1000, "A"))
+	}
+
+	report := NewLotReport("A", blocks, txs, prices, "fifo", lotDay(10))
+	if report.Remaining != 5e6 || report.Unmatched != 0 {
+		t.Errorf("Remaining = %d and Unmatched = %d, want 5000000 and 0", report.Remaining, report.Unmatched)
+	}
+	if len(report.Lots) != 1 || report.Lots[0].Round != 2 || report.Lots[0].Remaining != 5e6 {
+		t.Errorf("Lots = %+v, want half of the second lot", report.Lots)
+	}
+	var consumed int64
+	for _, disposal := range report.Disposals {
+		consumed += disposal.Amount
+	}
+	if consumed != 15e6 {
+		t.Errorf("consumed = %d, want 15000000", consumed)
+	}
+}
@@ -0,0 +1,113 @@
+package algo
+
+import (
+	"math"
+	"time"
+)
+
+// Luck represents the expected proposal rate of an account.
+type Luck struct {
+	Round        int64
+	StakeShare   float64
+	RoundTime    time.Duration
+	BlocksPerDay float64
+}
+
+// NewLuck creates a new Luck instance from the account stake and the online stake.
+func NewLuck(account *Account, supply *Supply, roundTime time.Duration) *Luck {
+	luck := Luck{Round: supply.CurrentRound, RoundTime: roundTime}
+	if supply.OnlineMoney > 0 {
+		luck.StakeShare = float64(account.Amount) / float64(supply.OnlineMoney)
+	}
+	if roundTime > 0 {
+		luck.BlocksPerDay = float64(24*time.Hour) / float64(roundTime)
+	}
+	return &luck
+}
+
+// FetchLuck fetches the online stake and round time and returns the luck for the account.
+func FetchLuck(account *Account) *Luck {
+	if account == nil {
+		return nil
+	}
+
+	supply := cachedSupply()
+	if supply == nil {
+		return nil
+	}
+
+	return NewLuck(account, supply, cachedAverageRoundTime(supply.CurrentRound))
+}
+
+// ExpectedWinsPerDay returns the expected number of proposals per day.
+func (l *Luck) ExpectedWinsPerDay() float64 {
+	return l.StakeShare * l.BlocksPerDay
+}
+
+// ExpectedWins returns the expected number of proposals over the given number of days.
+func (l *Luck) ExpectedWins(days float64) float64 {
+	return l.ExpectedWinsPerDay() * days
+}
+
+// LuckBucket represents the expected versus actual proposals of a payout.
+type LuckBucket struct {
+	Expected    float64
+	Actual      int64
+	Ratio       float64
+	Probability float64
+}
+
+// Bucket returns the expected versus actual proposals of the given payout.
+//
+// The probability is the chance of a result at least as far from the
+// expectation in the same direction, assuming proposals follow a Poisson
+// distribution.
+func (l *Luck) Bucket(payout PayoutDate) LuckBucket {
+	bucket := LuckBucket{
+		Expected: l.ExpectedWins(payout.Days),
+		Actual:   payout.TotalWins,
+	}
+	if bucket.Expected > 0 {
+		bucket.Ratio = float64(bucket.Actual) / bucket.Expected
+	}
+	if float64(bucket.Actual) < bucket.Expected {
+		bucket.Probability = PoissonCDF(bucket.Actual, bucket.Expected)
+	} else {
+		bucket.Probability = 1 - PoissonCDF(bucket.Actual-1, bucket.Expected)
+	}
+	return bucket
+}
+
+// Unusual returns true if the bucket is outside of normal variance.
+func (b LuckBucket) Unusual() bool {
+	return b.Probability < 0.05
+}
+
+// Unlucky returns true if the bucket has fewer proposals than expected.
+func (b LuckBucket) Unlucky() bool {
+	return float64(b.Actual) < b.Expected
+}
+
+// PoissonPMF returns the probability of exactly k events given the mean lambda.
+func PoissonPMF(k int64, lambda float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	if lambda <= 0 {
+		if k == 0 {
+			return 1
+		}
+		return 0
+	}
+	lgamma, _ := math.Lgamma(float64(k) + 1)
+	return math.Exp(float64(k)*math.Log(lambda) - lambda - lgamma)
+}
+
+// PoissonCDF returns the probability of at most k events given the mean lambda.
+func PoissonCDF(k int64, lambda float64) float64 {
+	var total float64
+	for i := int64(0); i <= k; i++ {
+		total += PoissonPMF(i, lambda)
+	}
+	return math.Min(total, 1)
+}
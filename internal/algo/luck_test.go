@@ -0,0 +1,115 @@
+package algo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// approx returns true if a and b are equal to within 1e-9.
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestPoissonPMF(t *testing.T) {
+	tests := []struct {
+		k      int64
+		lambda float64
+		want   float64
+	}{
+		{0, 2, math.Exp(-2)},
+		{2, 2, 2 * math.Exp(-2)},
+		{3, 1.5, math.Pow(1.5, 3) / 6 * math.Exp(-1.5)},
+		{-1, 2, 0},
+		{0, 0, 1},
+		{1, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := PoissonPMF(tt.k, tt.lambda); !approx(got, tt.want) {
+			t.Errorf("PoissonPMF(%d, %g) = %g, want %g", tt.k, tt.lambda, got, tt.want)
+		}
+	}
+}
+
+func TestPoissonCDF(t *testing.T) {
+	tests := []struct {
+		k      int64
+		lambda float64
+		want   float64
+	}{
+		{-1, 2, 0},
+		{0, 3, math.Exp(-3)},
+		{2, 2, 5 * math.Exp(-2)},
+		{5, 3, 18.4 * math.Exp(-3)},
+		{0, 0, 1},
+		{200, 3, 1},
+	}
+	for _, tt := range tests {
+		if got := PoissonCDF(tt.k, tt.lambda); !approx(got, tt.want) {
+			t.Errorf("PoissonCDF(%d, %g) = %g, want %g", tt.k, tt.lambda, got, tt.want)
+		}
+	}
+}
+
+func TestNewLuck(t *testing.T) {
+	luck := NewLuck(&Account{Amount: 1_000}, &Supply{CurrentRound: 42, OnlineMoney: 1_000_000}, 3*time.Second)
+	if luck.Round != 42 {
+		t.Errorf("Round = %d, want 42", luck.Round)
+	}
+	if !approx(luck.StakeShare, 0.001) {
+		t.Errorf("StakeShare = %g, want 0.001", luck.StakeShare)
+	}
+	if !approx(luck.BlocksPerDay, 28_800) {
+		t.Errorf("BlocksPerDay = %g, want 28800", luck.BlocksPerDay)
+	}
+	if !approx(luck.ExpectedWins(2), 57.6) {
+		t.Errorf("ExpectedWins(2) = %g, want 57.6", luck.ExpectedWins(2))
+	}
+
+	unknown := NewLuck(&Account{Amount: 1_000}, &Supply{}, 0)
+	if unknown.StakeShare != 0 || unknown.BlocksPerDay != 0 {
+		t.Errorf("NewLuck() without online stake or round time = %+v, want zero share and blocks", unknown)
+	}
+}
+
+func TestLuckBucket(t *testing.T) {
+	luck := &Luck{StakeShare: 0.001, BlocksPerDay: 1000} // One expected proposal per day
+	tests := []struct {
+		name        string
+		payout      PayoutDate
+		expected    float64
+		ratio       float64
+		probability float64
+		unusual     bool
+		unlucky     bool
+	}{
+		{"none in three days", PayoutDate{Days: 3}, 3, 0, math.Exp(-3), true, true},
+		{"as expected", PayoutDate{Days: 3, TotalWins: 3}, 3, 1, 1 - 8.5*math.Exp(-3), false, false},
+		{"twice expected", PayoutDate{Days: 3, TotalWins: 6}, 3, 2, 1 - 18.4*math.Exp(-3), false, false},
+		{"one fewer", PayoutDate{Days: 3, TotalWins: 2}, 3, 2.0 / 3, 8.5 * math.Exp(-3), false, true},
+		{"no days", PayoutDate{}, 0, 0, 1, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket := luck.Bucket(tt.payout)
+			if !approx(bucket.Expected, tt.expected) {
+				t.Errorf("Expected = %g, want %g", bucket.Expected, tt.expected)
+			}
+			if bucket.Actual != tt.payout.TotalWins {
+				t.Errorf("Actual = %d, want %d", bucket.Actual, tt.payout.TotalWins)
+			}
+			if !approx(bucket.Ratio, tt.ratio) {
+				t.Errorf("Ratio = %g, want %g", bucket.Ratio, tt.ratio)
+			}
+			if !approx(bucket.Probability, tt.probability) {
+				t.Errorf("Probability = %g, want %g", bucket.Probability, tt.probability)
+			}
+			if bucket.Unusual() != tt.unusual {
+				t.Errorf("Unusual() = %v, want %v", bucket.Unusual(), tt.unusual)
+			}
+			if bucket.Unlucky() != tt.unlucky {
+				t.Errorf("Unlucky() = %v, want %v", bucket.Unlucky(), tt.unlucky)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"sort"
@@ -14,6 +15,7 @@ import (
 	"github.com/calmdev/algorand-rewards/internal/app"
 	"github.com/calmdev/algorand-rewards/internal/format"
 	"github.com/calmdev/algorand-rewards/internal/nodely"
+	"github.com/calmdev/algorand-rewards/internal/price"
 )
 
 // RewardsCacheFile is the rewards cache file.
@@ -22,40 +24,116 @@ var RewardsCacheFile = "rewards.json"
 // Rewards represents a list of payouts.
 type Rewards struct {
 	Payouts     []PayoutDate
+	Daily       []PayoutDate
+	Blocks      []BlockHeader
+	Start       string
 	TotalPayout float64
 	TotalWins   int64
 	MinPayout   float64
 	MaxPayout   float64
+	TotalFiat   float64
+	Missing     int64 // Payouts without a price, left out of the fiat value
+	Currency    string
+	Luck        *Luck
+	Balances    *BalanceHistory
 }
 
 // NewRewards creates a new Rewards instance.
 func NewRewards(payouts []PayoutDate) *Rewards {
 	rewards := Rewards{
 		Payouts: payouts,
+		Daily:   make([]PayoutDate, len(payouts)),
 	}
+
+	// Keep the daily payouts sorted from oldest to newest
+	copy(rewards.Daily, payouts)
+	sort.Slice(rewards.Daily, func(i, j int) bool {
+		return rewards.Daily[i].Date < rewards.Daily[j].Date
+	})
+
+	// Limit the payouts to the selected date range
+	rewards.Start = RangeStart(app.CurrentApp().RewardsRange(), time.Now())
+	rewards.Payouts = []PayoutDate{}
+	for _, payout := range payouts {
+		if rewards.InRange(payout.Date) {
+			rewards.Payouts = append(rewards.Payouts, payout)
+		}
+	}
+
 	rewards.SortByView(app.CurrentApp().RewardsView())
 	rewards.TotalPayout = TotalPayout(rewards.Payouts)
 	rewards.TotalWins = TotalWins(rewards.Payouts)
 	rewards.MinPayout = MinPayout(rewards.Payouts)
 	rewards.MaxPayout = MaxPayout(rewards.Payouts)
+	rewards.TotalFiat = TotalFiat(rewards.Payouts)
+	rewards.Missing = TotalMissingPrices(rewards.Payouts)
 	return &rewards
 }
 
+// RangeStart returns the first date of the given date range, or an empty string for all dates.
+func RangeStart(dateRange string, now time.Time) string {
+	var start time.Time
+	switch dateRange {
+	case "last7":
+		start = now.AddDate(0, 0, -6)
+	case "last30":
+		start = now.AddDate(0, 0, -29)
+	case "last90":
+		start = now.AddDate(0, 0, -89)
+	case "last365":
+		start = now.AddDate(0, 0, -364)
+	case "thisYear":
+		start = CurrentCalendar().FiscalYearStartOf(now)
+	default:
+		return ""
+	}
+	return start.Format("2006-01-02")
+}
+
+// InRange returns true if the date (YYYY-MM-DD) is within the selected date range.
+func (r *Rewards) InRange(date string) bool {
+	return r.Start == "" || date >= r.Start
+}
+
 // Data returns the data for the rewards.
+//
+// Every row has the columns of the header. Payouts without a price are marked
+// as missing in their row, and their count is shown by the rewards view.
 func (r *Rewards) Data() [][]string {
 	var data = [][]string{{"Date", "Wins", "Fees Collected", "Bonus", "Rewards"}}
+	if r.Currency != "" {
+		data[0] = append(data[0], "Rewards ("+strings.ToUpper(r.Currency)+")")
+	}
+	if r.Luck != nil {
+		data[0] = append(data[0], "Expected Wins", "Luck", "Probability")
+	}
 
 	// Append payouts to data
 	for _, payout := range r.Payouts {
-		data = append(data, []string{
+		row := []string{
 			payout.Date,
 			format.Int(payout.TotalWins),
 			format.Float(payout.AlgoFeesCollected()),
 			format.Float(payout.AlgoBonus()),
 			format.Float(payout.AlgoPayout()),
-		})
+		}
+		if r.Currency != "" {
+			fiat := format.Fiat(payout.Fiat)
+			if payout.MissingPrices > 0 {
+				fiat = "missing"
+			}
+			row = append(row, fiat)
+		}
+		if r.Luck != nil {
+			bucket := r.Luck.Bucket(payout)
+			row = append(row,
+				format.FloatShort(bucket.Expected),
+				format.Percent(bucket.Ratio),
+				format.Percent(bucket.Probability),
+			)
+		}
+		data = append(data, row)
 	}
-
 	return data
 }
 
@@ -94,14 +172,18 @@ func (r *Rewards) ByDayofWeek() {
 	var data []PayoutDate
 	// Aggregate data by day of the week
 	for day, payouts := range weeklyPayouts {
-		var totalWins int64
-		var totalFees, totalBonus, totalRewards float64
+		var totalWins, totalMissing int64
+		var totalFees, totalBonus, totalRewards, totalDays, totalFiat, totalStakeDays float64
 
 		for _, payout := range payouts {
 			totalWins += payout.TotalWins
 			totalFees += payout.AlgoFeesCollected()
 			totalBonus += payout.AlgoBonus()
 			totalRewards += payout.AlgoPayout()
+			totalDays += payout.Days
+			totalFiat += payout.Fiat
+			totalStakeDays += payout.StakeDays
+			totalMissing += payout.MissingPrices
 		}
 
 		data = append(data, PayoutDate{
@@ -110,14 +192,17 @@ func (r *Rewards) ByDayofWeek() {
 			TotalWins:     totalWins,
 			Bonus:         int64(totalBonus * 1e6),
 			FeesCollected: int64(totalFees * 1e6 * 2),
+			Days:          totalDays,
+			Fiat:          totalFiat,
+			StakeDays:     totalStakeDays,
+			MissingPrices: totalMissing,
 		})
 	}
-	// Sort the payouts by day of the week start at Monday
-	daysOfWeek := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+	// Sort the payouts by day of the week starting at the first day of the week
 	var sortedData []PayoutDate
-	for _, day := range daysOfWeek {
+	for _, day := range CurrentCalendar().Weekdays() {
 		for _, payout := range data {
-			if payout.Date == day {
+			if payout.Date == day.String() {
 				sortedData = append(sortedData, payout)
 				break
 			}
@@ -130,6 +215,7 @@ func (r *Rewards) ByDayofWeek() {
 // ByWeek sorts the payouts by week.
 func (r *Rewards) ByWeek() {
 	// Group payouts by week
+	calendar := CurrentCalendar()
 	weeklyPayouts := make(map[string][]PayoutDate)
 	for _, payout := range r.Payouts {
 		date := payout.Date
@@ -137,22 +223,25 @@ func (r *Rewards) ByWeek() {
 		if err != nil {
 			continue
 		}
-		year, week := dateTime.ISOWeek()
-		weekKey := fmt.Sprintf("%d-W%02d", year, week)
+		weekKey := calendar.Week(dateTime)
 		weeklyPayouts[weekKey] = append(weeklyPayouts[weekKey], payout)
 	}
 
 	var data []PayoutDate
 	// Aggregate data by week
 	for week, payouts := range weeklyPayouts {
-		var totalWins int64
-		var totalFees, totalBonus, totalRewards float64
+		var totalWins, totalMissing int64
+		var totalFees, totalBonus, totalRewards, totalDays, totalFiat, totalStakeDays float64
 
 		for _, payout := range payouts {
 			totalWins += payout.TotalWins
 			totalFees += payout.AlgoFeesCollected()
 			totalBonus += payout.AlgoBonus()
 			totalRewards += payout.AlgoPayout()
+			totalDays += payout.Days
+			totalFiat += payout.Fiat
+			totalStakeDays += payout.StakeDays
+			totalMissing += payout.MissingPrices
 		}
 
 		data = append(data, PayoutDate{
@@ -161,6 +250,10 @@ func (r *Rewards) ByWeek() {
 			TotalWins:     totalWins,
 			Bonus:         int64(totalBonus * 1e6),
 			FeesCollected: int64(totalFees * 1e6 * 2),
+			Days:          totalDays,
+			Fiat:          totalFiat,
+			StakeDays:     totalStakeDays,
+			MissingPrices: totalMissing,
 		})
 	}
 
@@ -184,14 +277,18 @@ func (r *Rewards) ByMonth() {
 	var data []PayoutDate
 	// Aggregate data by month
 	for month, payouts := range monthlyPayouts {
-		var totalWins int64
-		var totalFees, totalBonus, totalRewards float64
+		var totalWins, totalMissing int64
+		var totalFees, totalBonus, totalRewards, totalDays, totalFiat, totalStakeDays float64
 
 		for _, payout := range payouts {
 			totalWins += payout.TotalWins
 			totalFees += payout.AlgoFeesCollected()
 			totalBonus += payout.AlgoBonus()
 			totalRewards += payout.AlgoPayout()
+			totalDays += payout.Days
+			totalFiat += payout.Fiat
+			totalStakeDays += payout.StakeDays
+			totalMissing += payout.MissingPrices
 		}
 
 		data = append(data, PayoutDate{
@@ -200,6 +297,10 @@ func (r *Rewards) ByMonth() {
 			TotalWins:     totalWins,
 			Bonus:         int64(totalBonus * 1e6),
 			FeesCollected: int64(totalFees * 1e6 * 2),
+			Days:          totalDays,
+			Fiat:          totalFiat,
+			StakeDays:     totalStakeDays,
+			MissingPrices: totalMissing,
 		})
 	}
 
@@ -217,36 +318,33 @@ func (r *Rewards) ByMonth() {
 
 // ByQuarter sorts the payouts by quarter.
 func (r *Rewards) ByQuarter() {
-	// Group payouts by quarter
+	// Group payouts by quarter of the fiscal year
+	calendar := CurrentCalendar()
 	quarterlyPayouts := make(map[string][]PayoutDate)
 	for _, payout := range r.Payouts {
-		year := payout.Date[:4] // Assuming the date format is YYYY-MM-DD
-		month := payout.Date[5:7]
-		var quarter string
-		switch month {
-		case "01", "02", "03":
-			quarter = year + "-Q1"
-		case "04", "05", "06":
-			quarter = year + "-Q2"
-		case "07", "08", "09":
-			quarter = year + "-Q3"
-		case "10", "11", "12":
-			quarter = year + "-Q4"
+		dateTime, err := time.Parse("2006-01-02", payout.Date)
+		if err != nil {
+			continue
 		}
+		quarter := calendar.Quarter(dateTime)
 		quarterlyPayouts[quarter] = append(quarterlyPayouts[quarter], payout)
 	}
 
 	var data []PayoutDate
 	// Aggregate data by quarter
 	for quarter, payouts := range quarterlyPayouts {
-		var totalWins int64
-		var totalFees, totalBonus, totalRewards float64
+		var totalWins, totalMissing int64
+		var totalFees, totalBonus, totalRewards, totalDays, totalFiat, totalStakeDays float64
 
 		for _, payout := range payouts {
 			totalWins += payout.TotalWins
 			totalFees += payout.AlgoFeesCollected()
 			totalBonus += payout.AlgoBonus()
 			totalRewards += payout.AlgoPayout()
+			totalDays += payout.Days
+			totalFiat += payout.Fiat
+			totalStakeDays += payout.StakeDays
+			totalMissing += payout.MissingPrices
 		}
 
 		data = append(data, PayoutDate{
@@ -255,32 +353,50 @@ func (r *Rewards) ByQuarter() {
 			TotalWins:     totalWins,
 			Bonus:         int64(totalBonus * 1e6),
 			FeesCollected: int64(totalFees * 1e6 * 2),
+			Days:          totalDays,
+			Fiat:          totalFiat,
+			StakeDays:     totalStakeDays,
+			MissingPrices: totalMissing,
 		})
 	}
 
+	// Sort the payouts by quarter
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Date > data[j].Date
+	})
+
 	r.Payouts = data
 }
 
 // ByYear sorts the payouts by year.
 func (r *Rewards) ByYear() {
-	// Group payouts by year
+	// Group payouts by fiscal year
+	calendar := CurrentCalendar()
 	yearlyPayouts := make(map[string][]PayoutDate)
 	for _, payout := range r.Payouts {
-		year := payout.Date[:4] // Assuming the date format is YYYY-MM-DD
+		dateTime, err := time.Parse("2006-01-02", payout.Date)
+		if err != nil {
+			continue
+		}
+		year := calendar.Year(dateTime)
 		yearlyPayouts[year] = append(yearlyPayouts[year], payout)
 	}
 
 	var data []PayoutDate
 	// Aggregate data by year
 	for year, payouts := range yearlyPayouts {
-		var totalWins int64
-		var totalFees, totalBonus, totalRewards float64
+		var totalWins, totalMissing int64
+		var totalFees, totalBonus, totalRewards, totalDays, totalFiat, totalStakeDays float64
 
 		for _, payout := range payouts {
 			totalWins += payout.TotalWins
 			totalFees += payout.AlgoFeesCollected()
 			totalBonus += payout.AlgoBonus()
 			totalRewards += payout.AlgoPayout()
+			totalDays += payout.Days
+			totalFiat += payout.Fiat
+			totalStakeDays += payout.StakeDays
+			totalMissing += payout.MissingPrices
 		}
 
 		data = append(data, PayoutDate{
@@ -289,6 +405,10 @@ func (r *Rewards) ByYear() {
 			TotalWins:     totalWins,
 			Bonus:         int64(totalBonus * 1e6),
 			FeesCollected: int64(totalFees * 1e6 * 2),
+			Days:          totalDays,
+			Fiat:          totalFiat,
+			StakeDays:     totalStakeDays,
+			MissingPrices: totalMissing,
 		})
 	}
 
@@ -304,6 +424,48 @@ func (r *Rewards) ByYear() {
 	r.Payouts = data
 }
 
+// PeriodKey returns the key of the period the time belongs to for the given view.
+//
+// Weeks, quarters and years follow the calendar selected in the app.
+func PeriodKey(view string, t time.Time) string {
+	calendar := CurrentCalendar()
+	switch view {
+	case "dayOfWeek":
+		return t.Weekday().String()
+	case "week":
+		return calendar.Week(t)
+	case "month":
+		return t.Format("2006-01")
+	case "quarter":
+		return calendar.Quarter(t)
+	case "year":
+		return calendar.Year(t)
+	default:
+		return t.Format("2006-01-02")
+	}
+}
+
+// BlocksIn returns the blocks proposed in the given period of the current view, newest first.
+func (r *Rewards) BlocksIn(period string) []BlockHeader {
+	view := app.CurrentApp().RewardsView()
+
+	var blocks []BlockHeader
+	for _, block := range r.Blocks {
+		if !r.InRange(block.Time().Format("2006-01-02")) {
+			continue
+		}
+		if PeriodKey(view, block.Time()) == period {
+			blocks = append(blocks, block)
+		}
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Round > blocks[j].Round
+	})
+
+	return blocks
+}
+
 // TotalPayout returns the total payout.
 func TotalPayout(payouts []PayoutDate) float64 {
 	var total float64
@@ -313,6 +475,24 @@ func TotalPayout(payouts []PayoutDate) float64 {
 	return total
 }
 
+// TotalFiat returns the total fiat value of the payouts.
+func TotalFiat(payouts []PayoutDate) float64 {
+	var total float64
+	for _, payout := range payouts {
+		total += payout.Fiat
+	}
+	return total
+}
+
+// TotalMissingPrices returns the number of payouts without a price.
+func TotalMissingPrices(payouts []PayoutDate) int64 {
+	var total int64
+	for _, payout := range payouts {
+		total += payout.MissingPrices
+	}
+	return total
+}
+
 // Total wins returns the total wins.
 func TotalWins(payouts []PayoutDate) int64 {
 	var total int64
@@ -325,12 +505,8 @@ func TotalWins(payouts []PayoutDate) int64 {
 // MinPayout returns the minimum payout.
 func MinPayout(payouts []PayoutDate) float64 {
 	var minPayout float64
-	for _, payout := range payouts {
-		if minPayout == 0 {
-			minPayout = payout.AlgoPayout()
-			continue
-		}
-		if payout.AlgoPayout() < minPayout {
+	for i, payout := range payouts {
+		if i == 0 || payout.AlgoPayout() < minPayout {
 			minPayout = payout.AlgoPayout()
 		}
 	}
@@ -376,6 +552,7 @@ type Blocks struct {
 
 // BlockHeader represents a block header.
 type BlockHeader struct {
+	Round          int64 `json:"round"`
 	Timestamp      int64 `json:"timestamp"`
 	ProposerPayout int64 `json:"proposer-payout"`
 	Bonus          int64 `json:"bonus"`
@@ -387,6 +564,21 @@ func (b *BlockHeader) PayoutAlgos() int64 {
 	return b.ProposerPayout
 }
 
+// AlgoPayout returns the payout in Algos.
+func (b *BlockHeader) AlgoPayout() float64 {
+	return float64(b.ProposerPayout) / 1e6
+}
+
+// AlgoBonus returns the bonus in Algos.
+func (b *BlockHeader) AlgoBonus() float64 {
+	return float64(b.Bonus) / 1e6
+}
+
+// AlgoFeesCollected returns the fees collected in Algos.
+func (b *BlockHeader) AlgoFeesCollected() float64 {
+	return float64(b.FeesCollected) / 1e6 / 2
+}
+
 // Time returns the timestamp as a time.Time.
 func (b *BlockHeader) Time() time.Time {
 	return time.Unix(b.Timestamp, 0)
@@ -394,12 +586,16 @@ func (b *BlockHeader) Time() time.Time {
 
 // PayoutDate represents a payout for a specific date.
 type PayoutDate struct {
-	Date          string `json:"date"`
-	Payout        int64  `json:"payout"`
-	Bonus         int64  `json:"bonus"`
-	FeesCollected int64  `json:"fees-collected"`
-	TotalWins     int64  `json:"totalWins"`
-	BestDay       bool   `json:"bestDay"`
+	Date          string  `json:"date"`
+	Payout        int64   `json:"payout"`
+	Bonus         int64   `json:"bonus"`
+	FeesCollected int64   `json:"fees-collected"`
+	TotalWins     int64   `json:"totalWins"`
+	BestDay       bool    `json:"bestDay"`
+	Days          float64 `json:"days"`
+	Fiat          float64 `json:"fiat"`
+	StakeDays     float64 `json:"stake-days"`
+	MissingPrices int64   `json:"missing-prices"` // Payouts without a price, left out of the fiat value
 }
 
 // AlgoPayout returns the payout in Algos.
@@ -454,6 +650,9 @@ func fetchBlockHeadersRecursive(client *nodely.ClientIndexer, address, nextToken
 func FetchRewards(address string) *Rewards {
 	client := nodely.NewClientIndexer()
 
+	rewardsCacheMu.Lock()
+	defer rewardsCacheMu.Unlock()
+
 	cacheFile, err := app.CurrentApp().CacheFile(RewardsCacheFile)
 	if err != nil {
 		return nil
@@ -471,13 +670,21 @@ func FetchRewards(address string) *Rewards {
 		if err != nil {
 			return nil
 		}
-		defer file.Close()
 		decoder := json.NewDecoder(file)
 		err = decoder.Decode(&blocks)
+		file.Close()
 		if err != nil {
 			return nil
 		}
 		fmt.Printf("Read %d blocks from cache\n", len(blocks))
+
+		// Refetch all blocks if the cache predates storing rounds
+		for _, block := range blocks {
+			if block.Round == 0 {
+				blocks = nil
+				break
+			}
+		}
 	}
 
 	// Determine the latest timestamp from the cached blocks
@@ -491,22 +698,40 @@ func FetchRewards(address string) *Rewards {
 	blocks = append(blocks, newBlocks...)
 
 	// Write the updated blocks back to the cache file
-	file, err := os.OpenFile(cacheFile.Path(), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return nil
-	}
-	defer file.Close()
-	encoder := json.NewEncoder(file)
-	err = encoder.Encode(blocks)
+	err = writeCacheFile(cacheFile.Path(), blocks)
 	if err != nil {
 		return nil
 	}
 	fmt.Printf("Wrote %d blocks to cache\n", len(blocks))
 
+	// Value each payout at the price of the day it was received
+	prices := price.FetchHistory()
+
+	rewards := NewRewards(dailyPayouts(blocks, prices, time.Now()))
+	rewards.Blocks = blocks
+	if prices != nil {
+		rewards.Currency = price.Currency()
+	}
+	return rewards
+}
+
+// dailyPayouts returns the payouts of the blocks grouped by date.
+//
+// Days without a win are filled in from the first block through today, so
+// the payouts cover every calendar day since the first win. Today only counts
+// the part of the day that has passed. Payouts without a price are counted as
+// missing rather than valued at zero.
+func dailyPayouts(blocks []BlockHeader, prices price.History, now time.Time) []PayoutDate {
 	// Create a map of payouts by date
 	payoutsByDate := make(map[string]PayoutDate)
 	for _, block := range blocks {
 		date := block.Time().Format("2006-01-02")
+		blockPrice, ok := prices.At(block.Time())
+		fiat := block.AlgoPayout() * blockPrice
+		var missing int64
+		if prices != nil && !ok {
+			missing = 1
+		}
 		if _, ok := payoutsByDate[date]; !ok {
 			payoutsByDate[date] = PayoutDate{
 				Date:          date,
@@ -514,6 +739,9 @@ func FetchRewards(address string) *Rewards {
 				TotalWins:     1,
 				Bonus:         block.Bonus,
 				FeesCollected: block.FeesCollected,
+				Days:          1,
+				Fiat:          fiat,
+				MissingPrices: missing,
 			}
 		} else {
 			payout := payoutsByDate[date].Payout + block.PayoutAlgos()
@@ -526,41 +754,48 @@ func FetchRewards(address string) *Rewards {
 				TotalWins:     totalWins,
 				Bonus:         bonus,
 				FeesCollected: feesCollected,
+				Days:          1,
+				Fiat:          payoutsByDate[date].Fiat + fiat,
+				MissingPrices: payoutsByDate[date].MissingPrices + missing,
 			}
 		}
 	}
 
-	// Fill any missing dates
+	// Fill any missing dates through today
 	var startDate time.Time
-	var endDate time.Time
 	for _, block := range blocks {
 		if startDate.IsZero() || block.Time().Before(startDate) {
 			startDate = block.Time()
 		}
-		if endDate.IsZero() || block.Time().After(endDate) {
-			endDate = block.Time()
-		}
 	}
-	for d := startDate; d.Before(endDate); d = d.AddDate(0, 0, 1) {
-		date := d.Format("2006-01-02")
-		if _, ok := payoutsByDate[date]; !ok {
-			payoutsByDate[date] = PayoutDate{Date: date, Payout: 0, TotalWins: 0}
+	if !startDate.IsZero() {
+		for d := startDate; d.Before(now); d = d.AddDate(0, 0, 1) {
+			date := d.Format("2006-01-02")
+			if _, ok := payoutsByDate[date]; !ok {
+				payoutsByDate[date] = PayoutDate{Date: date, Payout: 0, TotalWins: 0, Days: 1}
+			}
 		}
 	}
 
 	// Append today's date if not in the map
-	today := time.Now().Format("2006-01-02")
+	today := now.Format("2006-01-02")
 	if _, ok := payoutsByDate[today]; !ok {
 		payoutsByDate[today] = PayoutDate{Date: today, Payout: 0, TotalWins: 0}
 	}
 
+	// Only count the elapsed part of today
+	midnight, _ := time.ParseInLocation("2006-01-02", today, now.Location())
+	todayPayout := payoutsByDate[today]
+	todayPayout.Days = now.Sub(midnight).Hours() / 24
+	payoutsByDate[today] = todayPayout
+
 	// Create a slice of PayoutDate
 	var payouts []PayoutDate
 	for _, payout := range payoutsByDate {
 		payouts = append(payouts, payout)
 	}
 
-	return NewRewards(payouts)
+	return payouts
 }
 
 // ExportRewards exports the rewards to a CSV file.
@@ -569,7 +804,12 @@ func ExportRewards(address string, writeCloser fyne.URIWriteCloser) {
 	writer := csv.NewWriter(writeCloser)
 	defer writer.Flush()
 
-	data := FetchRewards(address).Data()
+	rewards := FetchRewards(address)
+	if rewards == nil {
+		return
+	}
+	rewards.Luck = FetchLuck(FetchAccount(address))
+	data := rewards.Data()
 
 	// Write the CSV header
 	err := writer.Write(data[0])
@@ -0,0 +1,60 @@
+package algo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/price"
+)
+
+func TestDailyPayoutsMissingPrices(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 10, 1, 11, 0, 0, 0, time.UTC)),
+		blockAt(2, time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)),
+		blockAt(3, time.Date(2026, 10, 18, 11, 0, 0, 0, time.UTC)),
+	}
+	prices := price.History{"2026-10-18": 0.25} // Keyed by UTC day
+
+	payouts := make(map[string]PayoutDate)
+	for _, payout := range dailyPayouts(blocks, prices, now) {
+		payouts[payout.Date] = payout
+	}
+	missingDate, pricedDate := blocks[0].Time().Format("2006-01-02"), blocks[2].Time().Format("2006-01-02")
+	if got := payouts[missingDate]; got.MissingPrices != 2 || got.Fiat != 0 {
+		t.Errorf("%s = %d missing and %.2f fiat, want 2 and 0", missingDate, got.MissingPrices, got.Fiat)
+	}
+	if got := payouts[pricedDate]; got.MissingPrices != 0 || got.Fiat != 2.5 {
+		t.Errorf("%s = %d missing and %.2f fiat, want 0 and 2.50", pricedDate, got.MissingPrices, got.Fiat)
+	}
+
+	for _, payout := range dailyPayouts(blocks, nil, now) {
+		if payout.MissingPrices != 0 {
+			t.Errorf("%s has %d missing prices without a price source", payout.Date, payout.MissingPrices)
+		}
+	}
+}
+
+func TestRewardsDataColumns(t *testing.T) {
+	r := &Rewards{
+		Currency: "usd",
+		Payouts: []PayoutDate{
+			{Date: "2026-10-01", Payout: 10e6, TotalWins: 1, Fiat: 2.5},
+			{Date: "2026-10-02", Payout: 10e6, TotalWins: 1, MissingPrices: 1},
+		},
+		Missing: 1,
+	}
+
+	data := r.Data()
+	if len(data) != 3 {
+		t.Fatalf("len(Data()) = %d, want the header and 2 payouts", len(data))
+	}
+	for i, row := range data {
+		if len(row) != len(data[0]) {
+			t.Errorf("row %d = %v, want %d columns", i, row, len(data[0]))
+		}
+	}
+	if fiat := data[2][len(data[2])-1]; fiat != "missing" {
+		t.Errorf("fiat = %q, want %q", fiat, "missing")
+	}
+}
@@ -0,0 +1,148 @@
+package algo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/calmdev/algorand-rewards/internal/format"
+)
+
+// histogramBins is the number of bins in the payout histogram.
+const histogramBins = 10
+
+// HistogramBin represents a range of per-block payouts and how often they occurred.
+type HistogramBin struct {
+	Min   float64
+	Max   float64
+	Count int64
+}
+
+// Label returns the label of the bin.
+func (b HistogramBin) Label() string {
+	return format.FloatShort(b.Min)
+}
+
+// Statistics represents the distribution of payouts.
+type Statistics struct {
+	Mean      float64
+	Median    float64
+	StdDev    float64
+	P10       float64
+	P90       float64
+	WinRate   float64
+	Histogram []HistogramBin
+
+	// Stake-normalised stats, set when the balance history is known
+	AverageStake       float64
+	RewardsPerThousand float64
+	WinsPerMillion     float64
+}
+
+// NewStatistics creates a new Statistics instance for the grouped payouts.
+//
+// The mean, median, standard deviation and percentiles are computed over the
+// payouts of the current view. The win rate uses the daily payouts and the
+// histogram uses the per-block payouts, both limited to the same date range.
+func NewStatistics(r *Rewards) *Statistics {
+	var stats Statistics
+
+	payouts := make([]float64, 0, len(r.Payouts))
+	for _, payout := range r.Payouts {
+		payouts = append(payouts, payout.AlgoPayout())
+	}
+	sort.Float64s(payouts)
+
+	if len(payouts) > 0 {
+		var total float64
+		for _, p := range payouts {
+			total += p
+		}
+		stats.Mean = total / float64(len(payouts))
+
+		var squares float64
+		for _, p := range payouts {
+			squares += (p - stats.Mean) * (p - stats.Mean)
+		}
+		stats.StdDev = math.Sqrt(squares / float64(len(payouts)))
+
+		stats.Median = Percentile(payouts, 50)
+		stats.P10 = Percentile(payouts, 10)
+		stats.P90 = Percentile(payouts, 90)
+	}
+
+	// Win rate over the days in range
+	var days, winDays int64
+	for _, payout := range r.Daily {
+		if !r.InRange(payout.Date) {
+			continue
+		}
+		days++
+		if payout.TotalWins > 0 {
+			winDays++
+		}
+	}
+	if days > 0 {
+		stats.WinRate = float64(winDays) / float64(days)
+	}
+
+	// Histogram of per-block payouts in range
+	var blockPayouts []float64
+	for _, block := range r.Blocks {
+		if !r.InRange(block.Time().Format("2006-01-02")) {
+			continue
+		}
+		blockPayouts = append(blockPayouts, float64(block.PayoutAlgos())/1e6)
+	}
+	stats.Histogram = Histogram(blockPayouts, histogramBins)
+
+	if r.Balances != nil {
+		totals := r.StakeTotals()
+		stats.AverageStake = totals.AverageStake()
+		stats.RewardsPerThousand = totals.RewardsPerThousand()
+		stats.WinsPerMillion = totals.WinsPerMillion()
+	}
+
+	return &stats
+}
+
+// Percentile returns the p-th percentile of sorted values using linear interpolation.
+func Percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+	return sorted[lower]*(1-weight) + sorted[upper]*weight
+}
+
+// Histogram returns the values grouped into the given number of equal width bins.
+func Histogram(values []float64, bins int) []HistogramBin {
+	if len(values) == 0 || bins <= 0 {
+		return nil
+	}
+
+	lo, hi := values[0], values[0]
+	for _, v := range values {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+
+	width := (hi - lo) / float64(bins)
+	histogram := make([]HistogramBin, bins)
+	for i := range histogram {
+		histogram[i].Min = lo + float64(i)*width
+		histogram[i].Max = lo + float64(i+1)*width
+	}
+
+	for _, v := range values {
+		i := bins - 1
+		if width > 0 {
+			i = min(int((v-lo)/width), bins-1)
+		}
+		histogram[i].Count++
+	}
+
+	return histogram
+}
@@ -0,0 +1,92 @@
+package algo
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Streaks represents runs of days with and without wins.
+type Streaks struct {
+	CurrentWinStreak int
+	LongestWinStreak int
+	CurrentDrySpell  int
+	LongestDrySpell  int
+	LastWin          time.Time
+	AverageGap       time.Duration
+	AverageGapRounds float64
+	DrySpellChance   float64
+}
+
+// NewStreaks creates a new Streaks instance from the daily payouts and blocks.
+//
+// Runs are counted over calendar days from the first payout through
+// yesterday, so days missing from the payouts count as days without wins.
+// Today is left out because it is not over yet. The luck is optional and is
+// used to judge whether the current dry spell is unusual for the stake.
+func NewStreaks(r *Rewards, luck *Luck, now time.Time) *Streaks {
+	var streaks Streaks
+
+	// Count runs of days with and without wins
+	wins := make(map[string]int64, len(r.Daily))
+	var first string
+	for _, payout := range r.Daily {
+		wins[payout.Date] += payout.TotalWins
+		if first == "" || payout.Date < first {
+			first = payout.Date
+		}
+	}
+	var winRun, dryRun int
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if start, err := time.ParseInLocation("2006-01-02", first, now.Location()); err == nil {
+		for d := start; d.Before(today); d = d.AddDate(0, 0, 1) {
+			if wins[d.Format("2006-01-02")] > 0 {
+				winRun++
+				dryRun = 0
+			} else {
+				winRun = 0
+				dryRun++
+			}
+			streaks.LongestWinStreak = max(streaks.LongestWinStreak, winRun)
+			streaks.LongestDrySpell = max(streaks.LongestDrySpell, dryRun)
+		}
+	}
+
+	// A win today extends the win streak, but a day without wins yet neither ends nor extends a run
+	if wins[today.Format("2006-01-02")] > 0 {
+		winRun++
+		dryRun = 0
+		streaks.LongestWinStreak = max(streaks.LongestWinStreak, winRun)
+	}
+	streaks.CurrentWinStreak = winRun
+	streaks.CurrentDrySpell = dryRun
+
+	// Measure the average gap between wins
+	blocks := make([]BlockHeader, len(r.Blocks))
+	copy(blocks, r.Blocks)
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Round < blocks[j].Round
+	})
+	if len(blocks) > 0 {
+		streaks.LastWin = blocks[len(blocks)-1].Time()
+	}
+	if n := len(blocks); n > 1 {
+		first, last := blocks[0], blocks[n-1]
+		streaks.AverageGap = last.Time().Sub(first.Time()) / time.Duration(n-1)
+		streaks.AverageGapRounds = float64(last.Round-first.Round) / float64(n-1)
+	}
+
+	// Chance of going this long without a win given the stake
+	streaks.DrySpellChance = 1
+	if luck != nil && !streaks.LastWin.IsZero() {
+		days := now.Sub(streaks.LastWin).Hours() / 24
+		streaks.DrySpellChance = math.Exp(-luck.ExpectedWins(days))
+	}
+
+	return &streaks
+}
+
+// UnusualDrySpell returns true if the time since the last win is unlikely given the stake.
+func (s *Streaks) UnusualDrySpell() bool {
+	return s.DrySpellChance < 0.05
+}
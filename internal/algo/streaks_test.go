@@ -0,0 +1,91 @@
+package algo
+
+import (
+	"testing"
+	"time"
+)
+
+// blockAt returns a block proposed at the given time.
+func blockAt(round int64, t time.Time) BlockHeader {
+	return BlockHeader{Round: round, Timestamp: t.Unix(), ProposerPayout: 10e6}
+}
+
+func TestDailyPayoutsFillThroughToday(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 10, 8, 20, 0, 0, 0, time.Local)),
+		blockAt(2, time.Date(2026, 10, 10, 20, 0, 0, 0, time.Local)),
+	}
+
+	payouts := dailyPayouts(blocks, nil, now)
+	dates := make(map[string]bool, len(payouts))
+	for _, payout := range payouts {
+		dates[payout.Date] = true
+	}
+	for d := time.Date(2026, 10, 8, 0, 0, 0, 0, time.Local); !d.After(now); d = d.AddDate(0, 0, 1) {
+		if !dates[d.Format("2006-01-02")] {
+			t.Errorf("payouts are missing %s", d.Format("2006-01-02"))
+		}
+	}
+	if len(payouts) != 12 {
+		t.Errorf("len(payouts) = %d, want 12", len(payouts))
+	}
+}
+
+func TestNewStreaksLastWinSeveralDaysAgo(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 10, 8, 9, 0, 0, 0, time.Local)),
+		blockAt(2, time.Date(2026, 10, 9, 9, 0, 0, 0, time.Local)),
+		blockAt(3, time.Date(2026, 10, 10, 9, 0, 0, 0, time.Local)),
+	}
+
+	tests := []struct {
+		name  string
+		daily []PayoutDate
+	}{
+		{"filled", dailyPayouts(blocks, nil, now)},
+		{"wins only", []PayoutDate{
+			{Date: "2026-10-08", TotalWins: 1},
+			{Date: "2026-10-09", TotalWins: 1},
+			{Date: "2026-10-10", TotalWins: 1},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streaks := NewStreaks(&Rewards{Daily: tt.daily, Blocks: blocks}, nil, now)
+			if streaks.CurrentWinStreak != 0 {
+				t.Errorf("CurrentWinStreak = %d, want 0", streaks.CurrentWinStreak)
+			}
+			if streaks.CurrentDrySpell != 8 {
+				t.Errorf("CurrentDrySpell = %d, want 8", streaks.CurrentDrySpell)
+			}
+			if streaks.LongestWinStreak != 3 {
+				t.Errorf("LongestWinStreak = %d, want 3", streaks.LongestWinStreak)
+			}
+			if streaks.LongestDrySpell != 8 {
+				t.Errorf("LongestDrySpell = %d, want 8", streaks.LongestDrySpell)
+			}
+		})
+	}
+}
+
+func TestNewStreaksToday(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	daily := []PayoutDate{
+		{Date: "2026-10-17", TotalWins: 1},
+		{Date: "2026-10-18", TotalWins: 1},
+		{Date: "2026-10-19", TotalWins: 0},
+	}
+
+	streaks := NewStreaks(&Rewards{Daily: daily}, nil, now)
+	if streaks.CurrentWinStreak != 2 || streaks.CurrentDrySpell != 0 {
+		t.Errorf("without a win today got streak %d and dry spell %d, want 2 and 0", streaks.CurrentWinStreak, streaks.CurrentDrySpell)
+	}
+
+	daily[2].TotalWins = 1
+	streaks = NewStreaks(&Rewards{Daily: daily}, nil, now)
+	if streaks.CurrentWinStreak != 3 || streaks.LongestWinStreak != 3 {
+		t.Errorf("with a win today got streak %d and longest %d, want 3 and 3", streaks.CurrentWinStreak, streaks.LongestWinStreak)
+	}
+}
@@ -0,0 +1,122 @@
+package algo
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/nodely"
+)
+
+const (
+	// DefaultRoundTime is the round time used when it cannot be measured.
+	DefaultRoundTime = 2800 * time.Millisecond
+
+	// roundTimeSample is the number of rounds used to measure the round time.
+	roundTimeSample = 10000
+
+	// supplyCacheDuration is how long a fetched supply is reused.
+	supplyCacheDuration = 30 * time.Second
+)
+
+// supplyCache holds the last fetched supply.
+var supplyCache struct {
+	sync.Mutex
+	supply  *Supply
+	fetched time.Time
+}
+
+// Supply represents the current supply of the ledger.
+type Supply struct {
+	CurrentRound int64 `json:"current_round"`
+	OnlineMoney  int64 `json:"online-money"`
+	TotalMoney   int64 `json:"total-money"`
+}
+
+// AlgoOnlineMoney returns the online stake in Algos.
+func (s *Supply) AlgoOnlineMoney() float64 {
+	return float64(s.OnlineMoney) / 1e6
+}
+
+// FetchSupply fetches the current supply from the nodely api.
+//
+// Docs: https://nodely.io/swagger/index.html?url=/swagger/api/4160/algod.oas3.yml#/public/GetSupply
+func FetchSupply() *Supply {
+	client := nodely.NewClient()
+
+	var supply Supply
+	err := client.Get("/v2/ledger/supply", &supply)
+	if err != nil {
+		return nil
+	}
+
+	return &supply
+}
+
+// cachedSupply returns the current supply, fetched at most every 30 seconds so
+// the header and the views rendered with it share one request.
+func cachedSupply() *Supply {
+	supplyCache.Lock()
+	defer supplyCache.Unlock()
+
+	if supplyCache.supply != nil && time.Since(supplyCache.fetched) < supplyCacheDuration {
+		return supplyCache.supply
+	}
+
+	supply := FetchSupply()
+	if supply != nil {
+		supplyCache.supply = supply
+		supplyCache.fetched = time.Now()
+	}
+
+	return supply
+}
+
+// Block represents a block returned by algod.
+type Block struct {
+	Block struct {
+		Round     int64  `json:"rnd"`
+		Timestamp int64  `json:"ts"`
+		Seed      []byte `json:"seed"`
+	} `json:"block"`
+}
+
+// Time returns the timestamp as a time.Time.
+func (b *Block) Time() time.Time {
+	return time.Unix(b.Block.Timestamp, 0)
+}
+
+// FetchBlock fetches the header of the block at the given round from the nodely api.
+//
+// Docs: https://nodely.io/swagger/index.html?url=/swagger/api/4160/algod.oas3.yml#/public/GetBlock
+func FetchBlock(round int64) *Block {
+	client := nodely.NewClient()
+
+	var block Block
+	err := client.Get(fmt.Sprintf("/v2/blocks/%d?header-only=true&format=json", round), &block)
+	if err != nil || block.Block.Timestamp == 0 {
+		return nil
+	}
+
+	return &block
+}
+
+// FetchAverageRoundTime measures the average round time over the rounds before the given round.
+func FetchAverageRoundTime(round int64) time.Duration {
+	if round <= roundTimeSample {
+		return DefaultRoundTime
+	}
+
+	last := FetchBlock(round)
+	first := FetchBlock(round - roundTimeSample)
+	if last == nil || first == nil {
+		return DefaultRoundTime
+	}
+
+	elapsed := last.Time().Sub(first.Time())
+	if elapsed <= 0 {
+		return DefaultRoundTime
+	}
+
+	return elapsed / roundTimeSample
+}
@@ -0,0 +1,263 @@
+package algo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	"github.com/calmdev/algorand-rewards/internal/price"
+)
+
+// TaxEntry represents a proposer payout received as income.
+type TaxEntry struct {
+	Time         time.Time
+	Round        int64
+	Amount       float64
+	Price        float64
+	Value        float64
+	PriceMissing bool // No price for the day, so the value is not known
+}
+
+// TaxMonth represents the income received in a month.
+type TaxMonth struct {
+	Month  string
+	Count  int
+	Amount float64
+	Value  float64
+}
+
+// TaxReport represents the income from proposer payouts received in a year.
+type TaxReport struct {
+	Year     int
+	Location *time.Location
+	Currency string
+	Entries  []TaxEntry
+	Months   []TaxMonth
+	Amount   float64
+	Value    float64
+	Missing  int // Payouts without a price, left out of the values
+}
+
+// NewTaxReport creates a new TaxReport of the payouts received in the given year.
+//
+// Payouts are dated in the given location so the year and month cut-offs
+// follow the jurisdiction rather than the machine running the app. The
+// fair market value is the price of the UTC day the payout was received, the
+// day the price history is keyed by.
+// Payouts without a price are counted as missing rather than valued at zero.
+func NewTaxReport(blocks []BlockHeader, prices price.History, currency string, year int, loc *time.Location) *TaxReport {
+	report := TaxReport{Year: year, Location: loc, Currency: currency}
+
+	months := make(map[string]*TaxMonth)
+	for _, block := range blocks {
+		received := block.Time().In(loc)
+		if received.Year() != year || block.ProposerPayout == 0 {
+			continue
+		}
+
+		entry := TaxEntry{
+			Time:   received,
+			Round:  block.Round,
+			Amount: block.AlgoPayout(),
+		}
+		price, ok := prices.At(received)
+		entry.Price, entry.PriceMissing = price, !ok
+		entry.Value = entry.Amount * entry.Price
+		if entry.PriceMissing {
+			report.Missing++
+		}
+		report.Entries = append(report.Entries, entry)
+
+		month := received.Format("2006-01")
+		if _, ok := months[month]; !ok {
+			months[month] = &TaxMonth{Month: month}
+		}
+		months[month].Count++
+		months[month].Amount += entry.Amount
+		months[month].Value += entry.Value
+		report.Amount += entry.Amount
+		report.Value += entry.Value
+	}
+
+	sort.Slice(report.Entries, func(i, j int) bool {
+		return report.Entries[i].Round < report.Entries[j].Round
+	})
+	for _, month := range months {
+		report.Months = append(report.Months, *month)
+	}
+	sort.Slice(report.Months, func(i, j int) bool {
+		return report.Months[i].Month < report.Months[j].Month
+	})
+
+	return &report
+}
+
+// TaxYears returns the years with proposer payouts in the given location, newest first.
+func TaxYears(blocks []BlockHeader, loc *time.Location) []int {
+	seen := make(map[int]bool)
+	var years []int
+	for _, block := range blocks {
+		year := block.Time().In(loc).Year()
+		if !seen[year] {
+			seen[year] = true
+			years = append(years, year)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(years)))
+	return years
+}
+
+// TaxLocation returns the tax timezone of the app, or the local timezone if none is set.
+func TaxLocation() *time.Location {
+	timezone := app.CurrentApp().TaxTimezone()
+	if timezone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// FetchTaxReport fetches the price history and returns the tax report of the given year for the rewards.
+func FetchTaxReport(rewards *Rewards, year int) *TaxReport {
+	if rewards == nil {
+		return nil
+	}
+
+	prices := price.FetchHistory()
+	var currency string
+	if prices != nil {
+		currency = price.Currency()
+	}
+
+	return NewTaxReport(rewards.Blocks, prices, currency, year, TaxLocation())
+}
+
+// valueHeader returns the header of the fair market value column.
+func (t *TaxReport) valueHeader() string {
+	if t.Currency == "" {
+		return "Value"
+	}
+	return "Value (" + strings.ToUpper(t.Currency) + ")"
+}
+
+// Data returns the data for the tax report with the monthly and annual totals.
+//
+// Every row has the columns of the header. Payouts without a price are marked
+// as missing in their row, and their count is shown by the tax view.
+func (t *TaxReport) Data() [][]string {
+	var data = [][]string{{"Date", "Time", "Round", "Amount (ALGO)", "Price", t.valueHeader()}}
+
+	// Append entries to data
+	for _, entry := range t.Entries {
+		price, value := fmt.Sprintf("%.6f", entry.Price), fmt.Sprintf("%.2f", entry.Value)
+		if entry.PriceMissing {
+			price, value = "missing", ""
+		}
+		data = append(data, []string{
+			entry.Time.Format("2006-01-02"),
+			entry.Time.Format("15:04:05 MST"),
+			fmt.Sprintf("%d", entry.Round),
+			fmt.Sprintf("%.6f", entry.Amount),
+			price,
+			value,
+		})
+	}
+
+	// Append totals to data after a blank row
+	data = append(data, make([]string, len(data[0])))
+	data = append(data, []string{"Month", "Payouts", "", "Amount (ALGO)", "", t.valueHeader()})
+	for _, month := range t.Months {
+		data = append(data, []string{
+			month.Month,
+			fmt.Sprintf("%d", month.Count),
+			"",
+			fmt.Sprintf("%.6f", month.Amount),
+			"",
+			fmt.Sprintf("%.2f", month.Value),
+		})
+	}
+	data = append(data, []string{
+		fmt.Sprintf("%d", t.Year),
+		fmt.Sprintf("%d", len(t.Entries)),
+		"",
+		fmt.Sprintf("%.6f", t.Amount),
+		"",
+		fmt.Sprintf("%.2f", t.Value),
+	})
+	return data
+}
+
+// ExportTaxReport exports the tax report to a CSV file.
+func ExportTaxReport(t *TaxReport, writeCloser fyne.URIWriteCloser) {
+	// Create a new CSV writer
+	writer := csv.NewWriter(writeCloser)
+	defer writer.Flush()
+
+	// Write the CSV rows
+	for _, row := range t.Data() {
+		err := writer.Write(row)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// taxReportTemplate is the printable HTML tax report.
+var taxReportTemplate = template.Must(template.New("tax").Funcs(template.FuncMap{
+	"algo": format.Float,
+	"fiat": format.Fiat,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Staking Rewards {{.Report.Year}}</title>
+<style>
+body { font-family: sans-serif; font-size: 12px; margin: 2em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 2em; }
+th, td { border-bottom: 1px solid #ccc; padding: 4px 8px; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+tfoot td { font-weight: bold; border-top: 2px solid #000; }
+@media print { h2 { page-break-before: always; } }
+</style>
+</head>
+<body>
+<h1>Staking Rewards {{.Report.Year}}</h1>
+<p>Account: {{.Address}}<br>Timezone: {{.Report.Location}}<br>Generated: {{.Generated}}</p>
+{{if .Report.Missing}}<p><strong>{{.Report.Missing}} payouts have no price and are left out of the values.</strong></p>
+{{end}}
+<table>
+<thead><tr><th>Month</th><th>Payouts</th><th>Amount (ALGO)</th><th>{{.ValueHeader}}</th></tr></thead>
+<tbody>
+{{range .Report.Months}}<tr><td>{{.Month}}</td><td>{{.Count}}</td><td>{{algo .Amount}}</td><td>{{fiat .Value}}</td></tr>
+{{end}}</tbody>
+<tfoot><tr><td>{{.Report.Year}}</td><td>{{len .Report.Entries}}</td><td>{{algo .Report.Amount}}</td><td>{{fiat .Report.Value}}</td></tr></tfoot>
+</table>
+<h2>Payouts</h2>
+<table>
+<thead><tr><th>Received</th><th>Round</th><th>Amount (ALGO)</th><th>Price</th><th>{{.ValueHeader}}</th></tr></thead>
+<tbody>
+{{range .Report.Entries}}<tr><td>{{.Time.Format "2006-01-02 15:04:05 MST"}}</td><td>{{.Round}}</td><td>{{algo .Amount}}</td>{{if .PriceMissing}}<td>missing</td><td></td>{{else}}<td>{{printf "%.6f" .Price}}</td><td>{{fiat .Value}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>
+`))
+
+// ExportTaxReportHTML exports the tax report to a printable HTML file.
+func ExportTaxReportHTML(t *TaxReport, address string, writeCloser fyne.URIWriteCloser) {
+	_ = taxReportTemplate.Execute(writeCloser, map[string]any{
+		"Report":      t,
+		"Address":     address,
+		"ValueHeader": t.valueHeader(),
+		"Generated":   time.Now().Format("2006-01-02 15:04"),
+	})
+}
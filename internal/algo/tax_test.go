@@ -0,0 +1,120 @@
+package algo
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/price"
+)
+
+func TestNewTaxReportNewYear(t *testing.T) {
+	newYork := time.FixedZone("EST", -5*60*60)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2025, 12, 31, 20, 0, 0, 0, time.UTC)), // New Year in Tokyo
+		blockAt(2, time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC)),    // Still the old year in New York
+		blockAt(3, time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)),
+	}
+	// Prices are keyed by UTC day whatever the tax location
+	prices := price.History{"2025-12-31": 1, "2026-01-01": 2}
+
+	tests := []struct {
+		name   string
+		loc    *time.Location
+		year   int
+		rounds []int64
+		months []string
+		value  float64
+	}{
+		{"utc old year", time.UTC, 2025, []int64{1}, []string{"2025-12"}, 10},
+		{"utc new year", time.UTC, 2026, []int64{2, 3}, []string{"2026-01"}, 40},
+		{"tokyo old year", tokyo, 2025, nil, nil, 0},
+		{"tokyo new year", tokyo, 2026, []int64{1, 2, 3}, []string{"2026-01"}, 50},
+		{"new york old year", newYork, 2025, []int64{1, 2}, []string{"2025-12"}, 30},
+		{"new york new year", newYork, 2026, []int64{3}, []string{"2026-01"}, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewTaxReport(blocks, prices, "usd", tt.year, tt.loc)
+
+			var rounds []int64
+			for _, entry := range report.Entries {
+				rounds = append(rounds, entry.Round)
+				if entry.Time.Location() != tt.loc {
+					t.Errorf("round %d dated in %s, want %s", entry.Round, entry.Time.Location(), tt.loc)
+				}
+			}
+			if !slices.Equal(rounds, tt.rounds) {
+				t.Errorf("rounds = %v, want %v", rounds, tt.rounds)
+			}
+			var months []string
+			for _, month := range report.Months {
+				months = append(months, month.Month)
+			}
+			if !slices.Equal(months, tt.months) {
+				t.Errorf("months = %v, want %v", months, tt.months)
+			}
+			if report.Value != tt.value || report.Amount != float64(10*len(tt.rounds)) {
+				t.Errorf("Value = %g and Amount = %g, want %g and %d", report.Value, report.Amount, tt.value, 10*len(tt.rounds))
+			}
+		})
+	}
+}
+
+func TestNewTaxReportMissingPrice(t *testing.T) {
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)),
+		blockAt(2, time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)),                  // No price in the week before
+		{Round: 3, Timestamp: time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC).Unix()}, // No payout
+	}
+	prices := price.History{"2026-03-01": 2}
+
+	report := NewTaxReport(blocks, prices, "usd", 2026, time.UTC)
+	if len(report.Entries) != 2 || !report.Entries[1].PriceMissing {
+		t.Fatalf("Entries = %+v, want the second payout without a price", report.Entries)
+	}
+	if report.Missing != 1 || report.Value != 20 || report.Amount != 20 {
+		t.Errorf("Missing = %d, Value = %g and Amount = %g, want 1, 20 and 20", report.Missing, report.Value, report.Amount)
+	}
+}
+
+func TestTaxYears(t *testing.T) {
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)),
+		blockAt(2, time.Date(2025, 12, 31, 20, 0, 0, 0, time.UTC)),
+		blockAt(3, time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		loc  *time.Location
+		want []int
+	}{
+		{time.UTC, []int{2026, 2025, 2024}},
+		{time.FixedZone("JST", 9*60*60), []int{2026, 2024}},
+	}
+	for _, tt := range tests {
+		if got := TaxYears(blocks, tt.loc); !slices.Equal(got, tt.want) {
+			t.Errorf("TaxYears(%s) = %v, want %v", tt.loc, got, tt.want)
+		}
+	}
+}
+
+func TestTaxReportDataColumns(t *testing.T) {
+	blocks := []BlockHeader{
+		blockAt(1, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)),
+		blockAt(2, time.Date(2026, 4, 20, 12, 0, 0, 0, time.UTC)), // No price
+	}
+	report := NewTaxReport(blocks, price.History{"2026-03-01": 2}, "usd", 2026, time.UTC)
+
+	data := report.Data()
+	// Header, 2 payouts, a blank row, the month header, 2 months and the year
+	if len(data) != 8 {
+		t.Fatalf("len(Data()) = %d, want 8", len(data))
+	}
+	for i, row := range data {
+		if len(row) != len(data[0]) {
+			t.Errorf("row %d = %v, want %d columns", i, row, len(data[0]))
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package algo
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// EligibilityFee is the key registration fee in microalgos that makes an account eligible for incentives.
+const EligibilityFee = 2_000_000
+
+// KeyRegEvent represents a key registration of an account.
+type KeyRegEvent struct {
+	ID         string
+	Round      int64
+	Time       time.Time
+	Online     bool
+	Eligible   bool // Paid the eligibility fee
+	Fee        int64
+	FirstValid int64
+	LastValid  int64
+	ValidFrom  time.Time // Estimated from the round time
+	ValidUntil time.Time // Estimated from the round time
+}
+
+// AlgoFee returns the fee in Algos.
+func (e *KeyRegEvent) AlgoFee() float64 {
+	return float64(e.Fee) / 1e6
+}
+
+// OnlinePeriod represents a period the account was online or offline.
+type OnlinePeriod struct {
+	Start    time.Time
+	End      time.Time
+	Online   bool
+	Eligible bool
+	Expired  bool // Offline because the key validity window ended
+	Pending  bool // Offline because the key validity window had not started
+}
+
+// Timeline represents the online and offline history of an account.
+type Timeline struct {
+	Events  []KeyRegEvent  // Oldest first
+	Periods []OnlinePeriod // Oldest first
+	Now     time.Time
+}
+
+// NewTimeline reconstructs the online and offline history from the key registrations of the account.
+//
+// The times of key validity windows are estimated from the round of each
+// registration and the average round time. An online period starts at the
+// first valid round of the key, so a key registered ahead of time leaves the
+// account offline until then.
+func NewTimeline(address string, txs []TransactionDetail, roundTime time.Duration, now time.Time) *Timeline {
+	timeline := Timeline{Now: now}
+
+	// estimate returns the estimated time of a round relative to the transaction.
+	estimate := func(tx TransactionDetail, round int64) time.Time {
+		return tx.Time().Add(time.Duration(round-tx.ConfirmedRound) * roundTime)
+	}
+
+	for _, tx := range txs {
+		if tx.Type != "keyreg" || tx.Sender != address || tx.KeyReg == nil {
+			continue
+		}
+		event := KeyRegEvent{
+			ID:     tx.ID,
+			Round:  tx.ConfirmedRound,
+			Time:   tx.Time(),
+			Online: len(tx.KeyReg.VoteKey) > 0 && !tx.KeyReg.NonParticipation,
+			Fee:    tx.Fee,
+		}
+		if event.Online {
+			event.Eligible = tx.Fee >= EligibilityFee
+			event.FirstValid = tx.KeyReg.VoteFirstValid
+			event.LastValid = tx.KeyReg.VoteLastValid
+			event.ValidFrom = estimate(tx, event.FirstValid)
+			event.ValidUntil = estimate(tx, event.LastValid)
+		}
+		timeline.Events = append(timeline.Events, event)
+	}
+	slices.SortFunc(timeline.Events, func(a, b KeyRegEvent) int {
+		return cmp.Compare(a.Round, b.Round)
+	})
+
+	// Periods between registrations, starting late when the key is not valid yet and ending early when it expires
+	for i, event := range timeline.Events {
+		end := now
+		if i+1 < len(timeline.Events) {
+			end = timeline.Events[i+1].Time
+		}
+		if !event.Online {
+			timeline.Periods = append(timeline.Periods, OnlinePeriod{Start: event.Time, End: end})
+			continue
+		}
+		start := event.Time
+		if event.ValidFrom.After(start) {
+			pending := OnlinePeriod{Start: start, End: end, Pending: true}
+			if event.ValidFrom.Before(end) {
+				pending.End = event.ValidFrom
+			}
+			timeline.Periods = append(timeline.Periods, pending)
+			start = pending.End
+			if !start.Before(end) {
+				continue
+			}
+		}
+		online := OnlinePeriod{Start: start, End: end, Online: true, Eligible: event.Eligible}
+		if event.ValidUntil.Before(end) {
+			online.End = event.ValidUntil
+			timeline.Periods = append(timeline.Periods, online)
+			timeline.Periods = append(timeline.Periods, OnlinePeriod{Start: event.ValidUntil, End: end, Expired: true})
+			continue
+		}
+		timeline.Periods = append(timeline.Periods, online)
+	}
+
+	return &timeline
+}
+
+// FetchTimeline returns the online and offline history of the account.
+func FetchTimeline(address string) *Timeline {
+	transactions := FetchTransactions(address)
+	if transactions == nil {
+		return nil
+	}
+	roundTime := DefaultRoundTime
+	if status := cachedStatus(); status != nil {
+		roundTime = cachedAverageRoundTime(status.LastRound)
+	}
+	return NewTimeline(address, transactions.Transactions, roundTime, time.Now())
+}
+
+// PeriodAt returns the period the time falls in, or nil if it is before the first registration.
+func (t *Timeline) PeriodAt(at time.Time) *OnlinePeriod {
+	for i := range t.Periods {
+		period := &t.Periods[i]
+		if !at.Before(period.Start) && at.Before(period.End) {
+			return period
+		}
+	}
+	return nil
+}
+
+// EligibleRegistrations returns the number of registrations that paid the eligibility fee.
+func (t *Timeline) EligibleRegistrations() int {
+	var eligible int
+	for _, event := range t.Events {
+		if event.Eligible {
+			eligible++
+		}
+	}
+	return eligible
+}
+
+// OnlineTime returns the total time the account was online.
+func (t *Timeline) OnlineTime() time.Duration {
+	var online time.Duration
+	for _, period := range t.Periods {
+		if period.Online {
+			online += period.End.Sub(period.Start)
+		}
+	}
+	return online
+}
@@ -0,0 +1,133 @@
+package algo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewTimeline(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	minutes := func(n int) time.Time {
+		return start.Add(time.Duration(n) * time.Minute)
+	}
+
+	// keyreg returns a key registration confirmed at the round, one round per minute from round 1000.
+	keyreg := func(round, firstValid, lastValid, fee int64) TransactionDetail {
+		tx := TransactionDetail{
+			ID:             "tx",
+			Type:           "keyreg",
+			Sender:         "A",
+			Fee:            fee,
+			ConfirmedRound: round,
+			Timestamp:      minutes(int(round - 1000)).Unix(),
+			KeyReg:         &KeyRegTransaction{NonParticipation: firstValid == 0},
+		}
+		if firstValid > 0 {
+			tx.KeyReg.VoteKey = []byte{1}
+			tx.KeyReg.VoteFirstValid = firstValid
+			tx.KeyReg.VoteLastValid = lastValid
+		}
+		return tx
+	}
+	offline := func(round int64) TransactionDetail {
+		return keyreg(round, 0, 0, 1000)
+	}
+
+	type period struct {
+		start, end int // Minutes from the start
+		online     bool
+		expired    bool
+		pending    bool
+	}
+	tests := []struct {
+		name    string
+		txs     []TransactionDetail
+		periods []period
+		online  time.Duration
+	}{
+		{
+			name:    "valid from the registration",
+			txs:     []TransactionDetail{keyreg(1000, 900, 100_000, 1000)},
+			periods: []period{{start: 0, end: 120, online: true}},
+			online:  120 * time.Minute,
+		},
+		{
+			name: "registered ahead of time",
+			txs:  []TransactionDetail{keyreg(1000, 1060, 100_000, 1000)},
+			periods: []period{
+				{start: 0, end: 60, pending: true},
+				{start: 60, end: 120, online: true},
+			},
+			online: 60 * time.Minute,
+		},
+		{
+			name: "offline before the key became valid",
+			txs:  []TransactionDetail{keyreg(1000, 1100, 100_000, 1000), offline(1050)},
+			periods: []period{
+				{start: 0, end: 50, pending: true},
+				{start: 50, end: 120},
+			},
+		},
+		{
+			name: "key expired",
+			txs:  []TransactionDetail{keyreg(1000, 1000, 1030, 1000)},
+			periods: []period{
+				{start: 0, end: 30, online: true},
+				{start: 30, end: 120, expired: true},
+			},
+			online: 30 * time.Minute,
+		},
+		{
+			name: "went offline",
+			txs:  []TransactionDetail{offline(1050), keyreg(1000, 1000, 100_000, 1000)},
+			periods: []period{
+				{start: 0, end: 50, online: true},
+				{start: 50, end: 120},
+			},
+			online: 50 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeline := NewTimeline("A", tt.txs, time.Minute, minutes(120))
+			if len(timeline.Periods) != len(tt.periods) {
+				t.Fatalf("Periods = %+v, want %d periods", timeline.Periods, len(tt.periods))
+			}
+			for i, want := range tt.periods {
+				got := timeline.Periods[i]
+				if !got.Start.Equal(minutes(want.start)) || !got.End.Equal(minutes(want.end)) {
+					t.Errorf("period %d = %s to %s, want %s to %s", i, got.Start, got.End, minutes(want.start), minutes(want.end))
+				}
+				if got.Online != want.online || got.Expired != want.expired || got.Pending != want.pending {
+					t.Errorf("period %d = %+v, want online %v, expired %v and pending %v", i, got, want.online, want.expired, want.pending)
+				}
+			}
+			if got := timeline.OnlineTime(); got != tt.online {
+				t.Errorf("OnlineTime() = %s, want %s", got, tt.online)
+			}
+		})
+	}
+}
+
+func TestNewTimelineEvents(t *testing.T) {
+	txs := []TransactionDetail{
+		{ID: "eligible", Type: "keyreg", Sender: "A", Fee: EligibilityFee, ConfirmedRound: 20, KeyReg: &KeyRegTransaction{VoteKey: []byte{1}, VoteFirstValid: 20, VoteLastValid: 1000}},
+		{ID: "ineligible", Type: "keyreg", Sender: "A", Fee: 1000, ConfirmedRound: 10, KeyReg: &KeyRegTransaction{VoteKey: []byte{1}, VoteFirstValid: 10, VoteLastValid: 1000}},
+		{ID: "other sender", Type: "keyreg", Sender: "B", Fee: EligibilityFee, ConfirmedRound: 30, KeyReg: &KeyRegTransaction{VoteKey: []byte{1}}},
+		{ID: "payment", Type: "pay", Sender: "A", ConfirmedRound: 40},
+	}
+
+	timeline := NewTimeline("A", txs, time.Minute, time.Unix(3600, 0))
+	if len(timeline.Events) != 2 || timeline.Events[0].ID != "ineligible" || timeline.Events[1].ID != "eligible" {
+		t.Fatalf("Events = %+v, want the registrations of the account oldest first", timeline.Events)
+	}
+	if got := timeline.EligibleRegistrations(); got != 1 {
+		t.Errorf("EligibleRegistrations() = %d, want 1", got)
+	}
+	if period := timeline.PeriodAt(time.Unix(1800, 0)); period == nil || !period.Eligible {
+		t.Errorf("PeriodAt() = %+v, want the eligible period", period)
+	}
+	if period := timeline.PeriodAt(time.Unix(-1, 0)); period != nil {
+		t.Errorf("PeriodAt() before the first registration = %+v, want nil", period)
+	}
+}
@@ -19,19 +19,39 @@ import (
 var TransactionCacheFile = "transactions.json"
 
 type PaymentTransaction struct {
-	Amount      int64  `json:"amount"`
-	CloseAmount int64  `json:"close-amount"`
-	Receiver    string `json:"receiver"`
+	Amount           int64  `json:"amount"`
+	CloseAmount      int64  `json:"close-amount"`
+	CloseRemainderTo string `json:"close-remainder-to"`
+	Receiver         string `json:"receiver"`
+}
+
+type HeartbeatTransaction struct {
+	Address     string `json:"hb-address"`
+	KeyDilution int64  `json:"hb-key-dilution"`
+	Seed        []byte `json:"hb-seed"`
+	VoteID      []byte `json:"hb-vote-id"`
+}
+
+type KeyRegTransaction struct {
+	NonParticipation bool   `json:"non-participation"`
+	SelectionKey     []byte `json:"selection-participation-key"`
+	VoteKey          []byte `json:"vote-participation-key"`
+	StateProofKey    []byte `json:"state-proof-key"`
+	VoteFirstValid   int64  `json:"vote-first-valid"`
+	VoteLastValid    int64  `json:"vote-last-valid"`
+	VoteKeyDilution  int64  `json:"vote-key-dilution"`
 }
 
 type TransactionDetail struct {
-	ID             string              `json:"id"`
-	Timestamp      int64               `json:"round-time"`
-	Type           string              `json:"tx-type"`
-	Sender         string              `json:"sender"`
-	Payment        *PaymentTransaction `json:"payment-transaction"`
-	ConfirmedRound int64               `json:"confirmed-round"`
-	Fee            int64               `json:"fee"`
+	ID             string                `json:"id"`
+	Timestamp      int64                 `json:"round-time"`
+	Type           string                `json:"tx-type"`
+	Sender         string                `json:"sender"`
+	Payment        *PaymentTransaction   `json:"payment-transaction"`
+	Heartbeat      *HeartbeatTransaction `json:"heartbeat-transaction"`
+	KeyReg         *KeyRegTransaction    `json:"keyreg-transaction"`
+	ConfirmedRound int64                 `json:"confirmed-round"`
+	Fee            int64                 `json:"fee"`
 }
 
 // Time returns the timestamp as a time.Time.
@@ -39,6 +59,21 @@ func (t *TransactionDetail) Time() time.Time {
 	return time.Unix(t.Timestamp, 0)
 }
 
+// missingDetails returns true if the transaction was cached before the details of its type were stored.
+func (t *TransactionDetail) missingDetails() bool {
+	switch t.Type {
+	case "hb":
+		return t.Heartbeat == nil
+	case "keyreg":
+		return t.KeyReg == nil
+	case "pay":
+		// A close amount without the account it closed to
+		return t.Payment != nil && t.Payment.CloseAmount > 0 && t.Payment.CloseRemainderTo == ""
+	default:
+		return false
+	}
+}
+
 // AlgoFee returns the fee in Algos.
 func (t *TransactionDetail) AlgoFee() float64 {
 	return float64(t.Fee) / 1e6
@@ -145,6 +180,9 @@ func fetchTransactionsRecursive(client *nodely.ClientIndexer, address, nextToken
 func FetchTransactions(address string) *TransactionList {
 	client := nodely.NewClientIndexer()
 
+	transactionCacheMu.Lock()
+	defer transactionCacheMu.Unlock()
+
 	cacheFile, err := app.CurrentApp().CacheFile(TransactionCacheFile)
 	if err != nil {
 		return nil
@@ -162,13 +200,21 @@ func FetchTransactions(address string) *TransactionList {
 		if err != nil {
 			return nil
 		}
-		defer file.Close()
 		decoder := json.NewDecoder(file)
 		err = decoder.Decode(&txs)
+		file.Close()
 		if err != nil {
 			return nil
 		}
 		fmt.Printf("Read %d transactions from cache\n", len(txs))
+
+		// Refetch all transactions if the cache predates storing transaction details
+		for _, tx := range txs {
+			if tx.missingDetails() {
+				txs = nil
+				break
+			}
+		}
 	}
 
 	// Determine the latest timestamp from the cached blocks
@@ -182,13 +228,7 @@ func FetchTransactions(address string) *TransactionList {
 	txs = append(newTxs, txs...)
 
 	// Write the updated blocks back to the cache file
-	file, err := os.OpenFile(cacheFile.Path(), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return nil
-	}
-	defer file.Close()
-	encoder := json.NewEncoder(file)
-	err = encoder.Encode(txs)
+	err = writeCacheFile(cacheFile.Path(), txs)
 	if err != nil {
 		return nil
 	}
@@ -0,0 +1,147 @@
+package algo
+
+import (
+	"math"
+	"time"
+)
+
+// trendFlatThreshold is the relative change below which a trend is flat.
+const trendFlatThreshold = 0.05
+
+// MovingAverageWindows are the number of days of the moving averages.
+var MovingAverageWindows = []int{7, 30, 90}
+
+// MovingAverage represents the average daily rewards and wins over a window of days.
+type MovingAverage struct {
+	Rewards float64
+	Wins    float64
+}
+
+// MovingAverages returns the moving averages of the daily payouts keyed by date.
+//
+// Each window covers the calendar days up to and including the date, so days
+// missing from the payouts count as days without rewards. The first days of
+// the series average over the days available so far, and today over the part
+// of it that has passed.
+func MovingAverages(daily []PayoutDate, window int) map[string]MovingAverage {
+	averages := make(map[string]MovingAverage, len(daily))
+	byDate, first := dailyByDate(daily)
+
+	for _, payout := range daily {
+		day, err := time.ParseInLocation("2006-01-02", payout.Date, time.Local)
+		if err != nil {
+			continue
+		}
+
+		var rewards, days float64
+		var wins int64
+		for i := 0; i < window && !day.AddDate(0, 0, -i).Before(first); i++ {
+			p, ok := byDate[day.AddDate(0, 0, -i).Format("2006-01-02")]
+			rewards += p.AlgoPayout()
+			wins += p.TotalWins
+			// Today only counts the part of the day that has passed
+			if ok && p.Days < 1 {
+				days += p.Days
+			} else {
+				days++
+			}
+		}
+		days = max(days, 1)
+
+		averages[payout.Date] = MovingAverage{
+			Rewards: rewards / days,
+			Wins:    float64(wins) / days,
+		}
+	}
+
+	return averages
+}
+
+// dailyByDate returns the daily payouts keyed by date and the first day of the payouts.
+func dailyByDate(daily []PayoutDate) (map[string]PayoutDate, time.Time) {
+	byDate := make(map[string]PayoutDate, len(daily))
+	var first time.Time
+	for _, payout := range daily {
+		byDate[payout.Date] = payout
+		day, err := time.ParseInLocation("2006-01-02", payout.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		if first.IsZero() || day.Before(first) {
+			first = day
+		}
+	}
+	return byDate, first
+}
+
+// Trend represents the change in rewards and wins between the current and previous window.
+type Trend struct {
+	Window          int
+	Rewards         float64
+	PreviousRewards float64
+	Wins            int64
+	PreviousWins    int64
+	Change          float64 // Relative change in rewards
+	WinsChange      float64 // Relative change in wins
+}
+
+// NewTrend creates a new Trend comparing the last window of days with the one before.
+//
+// The windows are calendar days counting back from yesterday, so days missing
+// from the payouts count as days without rewards. Today is left out because
+// it is not over yet.
+func NewTrend(daily []PayoutDate, window int, now time.Time) Trend {
+	trend := Trend{Window: window}
+
+	byDate, _ := dailyByDate(daily)
+	yesterday := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, time.Local)
+	for i := 0; i < 2*window; i++ {
+		payout := byDate[yesterday.AddDate(0, 0, -i).Format("2006-01-02")]
+		if i < window {
+			trend.Rewards += payout.AlgoPayout()
+			trend.Wins += payout.TotalWins
+		} else {
+			trend.PreviousRewards += payout.AlgoPayout()
+			trend.PreviousWins += payout.TotalWins
+		}
+	}
+
+	trend.Change = relativeChange(trend.Rewards, trend.PreviousRewards)
+	trend.WinsChange = relativeChange(float64(trend.Wins), float64(trend.PreviousWins))
+
+	return trend
+}
+
+// relativeChange returns the change from previous to current relative to previous, or 1 if only current is positive.
+func relativeChange(current, previous float64) float64 {
+	switch {
+	case previous > 0:
+		return (current - previous) / previous
+	case current > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// direction returns whether a relative change is up, down or flat.
+func direction(change float64) string {
+	switch {
+	case math.Abs(change) < trendFlatThreshold:
+		return "flat"
+	case change > 0:
+		return "up"
+	default:
+		return "down"
+	}
+}
+
+// Direction returns whether the rewards trend is up, down or flat.
+func (t Trend) Direction() string {
+	return direction(t.Change)
+}
+
+// WinsDirection returns whether the wins trend is up, down or flat.
+func (t Trend) WinsDirection() string {
+	return direction(t.WinsChange)
+}
@@ -0,0 +1,116 @@
+package algo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// winsOn returns daily payouts of one win of 10 ALGO on each date.
+func winsOn(dates ...string) []PayoutDate {
+	var daily []PayoutDate
+	for _, date := range dates {
+		daily = append(daily, PayoutDate{Date: date, Payout: 10e6, TotalWins: 1, Days: 1})
+	}
+	return daily
+}
+
+func TestNewTrendGap(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+
+	// Wins every day until three weeks ago, none since and no days filled in
+	var dates []string
+	for d := time.Date(2026, 9, 1, 0, 0, 0, 0, time.Local); d.Before(time.Date(2026, 9, 28, 0, 0, 0, 0, time.Local)); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d.Format("2006-01-02"))
+	}
+	daily := append(winsOn(dates...), PayoutDate{Date: "2026-10-19"})
+
+	trend := NewTrend(daily, 7, now)
+	if trend.Rewards != 0 || trend.Wins != 0 {
+		t.Errorf("current window = %.2f rewards and %d wins, want none", trend.Rewards, trend.Wins)
+	}
+	if trend.PreviousRewards != 0 {
+		t.Errorf("previous window = %.2f rewards, want none", trend.PreviousRewards)
+	}
+
+	trend = NewTrend(daily, 30, now)
+	if trend.Rewards != 90 || trend.PreviousRewards != 180 {
+		t.Errorf("30 day windows = %.2f and %.2f rewards, want 90 and 180", trend.Rewards, trend.PreviousRewards)
+	}
+	if trend.Direction() != "down" {
+		t.Errorf("Direction() = %q, want down", trend.Direction())
+	}
+}
+
+func TestMovingAveragesGap(t *testing.T) {
+	daily := winsOn("2026-10-01", "2026-10-02", "2026-10-16")
+
+	averages := MovingAverages(daily, 7)
+	if got := averages["2026-10-02"].Rewards; got != 10 {
+		t.Errorf("2026-10-02 average = %.2f, want 10 over the days so far", got)
+	}
+	if got := averages["2026-10-16"].Rewards; math.Abs(got-10.0/7) > 1e-9 {
+		t.Errorf("2026-10-16 average = %.4f, want %.4f over 7 calendar days", got, 10.0/7)
+	}
+}
+
+func TestMovingAveragesToday(t *testing.T) {
+	// Half of today has passed
+	daily := append(winsOn("2026-10-17", "2026-10-18"), PayoutDate{Date: "2026-10-19", Payout: 10e6, TotalWins: 1, Days: 0.5})
+
+	averages := MovingAverages(daily, 7)
+	if got := averages["2026-10-19"]; got.Rewards != 12 || got.Wins != 1.2 {
+		t.Errorf("2026-10-19 average = %.2f rewards and %.2f wins, want 12 and 1.2 over 2.5 days", got.Rewards, got.Wins)
+	}
+	if got := averages["2026-10-18"].Rewards; got != 10 {
+		t.Errorf("2026-10-18 average = %.2f, want 10", got)
+	}
+}
+
+func TestNewTrendWins(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+
+	// Fewer but larger wins in the last week than in the week before
+	daily := []PayoutDate{
+		{Date: "2026-10-06", Payout: 10e6, TotalWins: 1, Days: 1},
+		{Date: "2026-10-07", Payout: 10e6, TotalWins: 1, Days: 1},
+		{Date: "2026-10-08", Payout: 10e6, TotalWins: 1, Days: 1},
+		{Date: "2026-10-09", Payout: 10e6, TotalWins: 1, Days: 1},
+		{Date: "2026-10-15", Payout: 50e6, TotalWins: 2, Days: 1},
+	}
+
+	trend := NewTrend(daily, 7, now)
+	if trend.Wins != 2 || trend.PreviousWins != 4 {
+		t.Errorf("wins = %d and %d, want 2 and 4", trend.Wins, trend.PreviousWins)
+	}
+	if trend.WinsChange != -0.5 || trend.WinsDirection() != "down" {
+		t.Errorf("wins trend = %.2f %s, want -0.50 down", trend.WinsChange, trend.WinsDirection())
+	}
+	if trend.Change != 0.25 || trend.Direction() != "up" {
+		t.Errorf("rewards trend = %.2f %s, want 0.25 up", trend.Change, trend.Direction())
+	}
+}
+
+func TestRelativeChange(t *testing.T) {
+	tests := []struct {
+		current, previous float64
+		want              float64
+		direction         string
+	}{
+		{110, 100, 0.1, "up"},
+		{90, 100, -0.1, "down"},
+		{102, 100, 0.02, "flat"},
+		{5, 0, 1, "up"},
+		{0, 0, 0, "flat"},
+		{0, 5, -1, "down"},
+	}
+	for _, tt := range tests {
+		got := relativeChange(tt.current, tt.previous)
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("relativeChange(%g, %g) = %g, want %g", tt.current, tt.previous, got, tt.want)
+		}
+		if d := direction(got); d != tt.direction {
+			t.Errorf("direction(%g) = %q, want %q", got, d, tt.direction)
+		}
+	}
+}
@@ -15,10 +15,32 @@ const (
 	AppID   = "com.calmdev.algorand-rewards"
 
 	// Preference keys
-	AddressKey     = "Address"
-	GUIDKey        = "GUID"
-	RewardsViewKey = "RewardsView"
-	VersionKey     = "Version"
+	AddressKey           = "Address"
+	GUIDKey              = "GUID"
+	RewardsViewKey       = "RewardsView"
+	RewardsPanelKey      = "RewardsPanel"
+	RewardsRangeKey      = "RewardsRange"
+	RewardsColumnsKey    = "RewardsColumns"
+	MonthlyGoalKey       = "MonthlyGoal"
+	YearlyGoalKey        = "YearlyGoal"
+	PriceSourceKey       = "PriceSource"
+	PriceFileKey         = "PriceFile"
+	PriceURLKey          = "PriceURL"
+	CurrencyKey          = "Currency"
+	TaxTimezoneKey       = "TaxTimezone"
+	LotMethodKey         = "LotMethod"
+	WeekStartKey         = "WeekStart"
+	FiscalYearKey        = "FiscalYearStart"
+	KeyExpiryKey         = "KeyExpiryWarnings"
+	KeyNotifiedKey       = "KeyExpiryNotified"
+	AbsentNotifiedKey    = "AbsentNotified"
+	HeartbeatAlertKey    = "HeartbeatAlertHours"
+	HeartbeatNotifiedKey = "HeartbeatNotified"
+	StakeNotifiedKey     = "StakeNotified"
+	TelemetryURLKey      = "TelemetryURL"
+	FleetAddressesKey    = "FleetAddresses"
+	FleetSortKey         = "FleetSort"
+	VersionKey           = "Version"
 )
 
 // CurrentApp returns the current instance of the App.
@@ -86,6 +108,16 @@ func (a *App) SetGUID(value string) {
 	a.Preferences().SetString(GUIDKey, value)
 }
 
+// TelemetryURL returns the node TelemetryURL associated with the app.
+func (a *App) TelemetryURL() string {
+	return a.Preferences().String(TelemetryURLKey)
+}
+
+// SetTelemetryURL sets the node TelemetryURL associated with the app.
+func (a *App) SetTelemetryURL(value string) {
+	a.Preferences().SetString(TelemetryURLKey, value)
+}
+
 // RewardsView returns the RevardsView associated with the app.
 func (a *App) RewardsView() string {
 	return a.Preferences().String(RewardsViewKey)
@@ -96,6 +128,216 @@ func (a *App) SetRewardsView(value string) {
 	a.Preferences().SetString(RewardsViewKey, value)
 }
 
+// RewardsPanel returns the RewardsPanel associated with the app.
+func (a *App) RewardsPanel() string {
+	return a.Preferences().String(RewardsPanelKey)
+}
+
+// SetRewardsPanel sets the RewardsPanel associated with the app.
+func (a *App) SetRewardsPanel(value string) {
+	a.Preferences().SetString(RewardsPanelKey, value)
+}
+
+// RewardsRange returns the RewardsRange associated with the app.
+func (a *App) RewardsRange() string {
+	return a.Preferences().String(RewardsRangeKey)
+}
+
+// SetRewardsRange sets the RewardsRange associated with the app.
+func (a *App) SetRewardsRange(value string) {
+	a.Preferences().SetString(RewardsRangeKey, value)
+}
+
+// RewardsColumns returns the optional RewardsColumns associated with the app.
+func (a *App) RewardsColumns() []string {
+	return a.Preferences().StringList(RewardsColumnsKey)
+}
+
+// SetRewardsColumns sets the optional RewardsColumns associated with the app.
+func (a *App) SetRewardsColumns(value []string) {
+	a.Preferences().SetStringList(RewardsColumnsKey, value)
+}
+
+// MonthlyGoal returns the MonthlyGoal in ALGO associated with the app.
+func (a *App) MonthlyGoal() float64 {
+	return a.Preferences().Float(MonthlyGoalKey)
+}
+
+// SetMonthlyGoal sets the MonthlyGoal in ALGO associated with the app.
+func (a *App) SetMonthlyGoal(value float64) {
+	a.Preferences().SetFloat(MonthlyGoalKey, value)
+}
+
+// YearlyGoal returns the YearlyGoal in ALGO associated with the app.
+func (a *App) YearlyGoal() float64 {
+	return a.Preferences().Float(YearlyGoalKey)
+}
+
+// SetYearlyGoal sets the YearlyGoal in ALGO associated with the app.
+func (a *App) SetYearlyGoal(value float64) {
+	a.Preferences().SetFloat(YearlyGoalKey, value)
+}
+
+// PriceSource returns the PriceSource ("csv" or "http") associated with the app.
+func (a *App) PriceSource() string {
+	return a.Preferences().String(PriceSourceKey)
+}
+
+// SetPriceSource sets the PriceSource associated with the app.
+func (a *App) SetPriceSource(value string) {
+	a.Preferences().SetString(PriceSourceKey, value)
+}
+
+// PriceFile returns the PriceFile of the CSV price source associated with the app.
+func (a *App) PriceFile() string {
+	return a.Preferences().String(PriceFileKey)
+}
+
+// SetPriceFile sets the PriceFile of the CSV price source associated with the app.
+func (a *App) SetPriceFile(value string) {
+	a.Preferences().SetString(PriceFileKey, value)
+}
+
+// PriceURL returns the PriceURL of the HTTP price source associated with the app.
+func (a *App) PriceURL() string {
+	return a.Preferences().String(PriceURLKey)
+}
+
+// SetPriceURL sets the PriceURL of the HTTP price source associated with the app.
+func (a *App) SetPriceURL(value string) {
+	a.Preferences().SetString(PriceURLKey, value)
+}
+
+// Currency returns the fiat Currency associated with the app.
+func (a *App) Currency() string {
+	return a.Preferences().String(CurrencyKey)
+}
+
+// SetCurrency sets the fiat Currency associated with the app.
+func (a *App) SetCurrency(value string) {
+	a.Preferences().SetString(CurrencyKey, value)
+}
+
+// TaxTimezone returns the TaxTimezone (IANA name) associated with the app.
+func (a *App) TaxTimezone() string {
+	return a.Preferences().String(TaxTimezoneKey)
+}
+
+// SetTaxTimezone sets the TaxTimezone associated with the app.
+func (a *App) SetTaxTimezone(value string) {
+	a.Preferences().SetString(TaxTimezoneKey, value)
+}
+
+// LotMethod returns the cost basis LotMethod associated with the app.
+func (a *App) LotMethod() string {
+	return a.Preferences().String(LotMethodKey)
+}
+
+// SetLotMethod sets the cost basis LotMethod associated with the app.
+func (a *App) SetLotMethod(value string) {
+	a.Preferences().SetString(LotMethodKey, value)
+}
+
+// WeekStart returns the first day of the week (0 is Sunday) associated with the app.
+func (a *App) WeekStart() int {
+	return a.Preferences().IntWithFallback(WeekStartKey, 1)
+}
+
+// SetWeekStart sets the first day of the week associated with the app.
+func (a *App) SetWeekStart(value int) {
+	a.Preferences().SetInt(WeekStartKey, value)
+}
+
+// FiscalYearStart returns the first month of the fiscal year (1 is January) associated with the app.
+func (a *App) FiscalYearStart() int {
+	return a.Preferences().IntWithFallback(FiscalYearKey, 1)
+}
+
+// SetFiscalYearStart sets the first month of the fiscal year associated with the app.
+func (a *App) SetFiscalYearStart(value int) {
+	a.Preferences().SetInt(FiscalYearKey, value)
+}
+
+// KeyExpiryWarnings returns the days before participation key expiry to warn at, such as "14,3,1".
+func (a *App) KeyExpiryWarnings() string {
+	return a.Preferences().StringWithFallback(KeyExpiryKey, "14,3,1")
+}
+
+// SetKeyExpiryWarnings sets the days before participation key expiry to warn at.
+func (a *App) SetKeyExpiryWarnings(value string) {
+	a.Preferences().SetString(KeyExpiryKey, value)
+}
+
+// KeyExpiryNotified returns the last participation key expiry warning sent as a notification.
+func (a *App) KeyExpiryNotified() string {
+	return a.Preferences().String(KeyNotifiedKey)
+}
+
+// SetKeyExpiryNotified sets the last participation key expiry warning sent as a notification.
+func (a *App) SetKeyExpiryNotified(value string) {
+	a.Preferences().SetString(KeyNotifiedKey, value)
+}
+
+// AbsentNotified returns the last suspension risk warning sent as a notification.
+func (a *App) AbsentNotified() string {
+	return a.Preferences().String(AbsentNotifiedKey)
+}
+
+// SetAbsentNotified sets the last suspension risk warning sent as a notification.
+func (a *App) SetAbsentNotified(value string) {
+	a.Preferences().SetString(AbsentNotifiedKey, value)
+}
+
+// HeartbeatAlertHours returns the hours without a heartbeat to alert after, or 0 to use the usual interval.
+func (a *App) HeartbeatAlertHours() float64 {
+	return a.Preferences().Float(HeartbeatAlertKey)
+}
+
+// SetHeartbeatAlertHours sets the hours without a heartbeat to alert after.
+func (a *App) SetHeartbeatAlertHours(value float64) {
+	a.Preferences().SetFloat(HeartbeatAlertKey, value)
+}
+
+// HeartbeatAlertNotified returns the last missed heartbeat warning sent as a notification.
+func (a *App) HeartbeatAlertNotified() string {
+	return a.Preferences().String(HeartbeatNotifiedKey)
+}
+
+// SetHeartbeatAlertNotified sets the last missed heartbeat warning sent as a notification.
+func (a *App) SetHeartbeatAlertNotified(value string) {
+	a.Preferences().SetString(HeartbeatNotifiedKey, value)
+}
+
+// StakeNotified returns the last stake eligibility warning sent as a notification.
+func (a *App) StakeNotified() string {
+	return a.Preferences().String(StakeNotifiedKey)
+}
+
+// SetStakeNotified sets the last stake eligibility warning sent as a notification.
+func (a *App) SetStakeNotified(value string) {
+	a.Preferences().SetString(StakeNotifiedKey, value)
+}
+
+// FleetAddresses returns the FleetAddresses shown in the fleet overview associated with the app.
+func (a *App) FleetAddresses() []string {
+	return a.Preferences().StringList(FleetAddressesKey)
+}
+
+// SetFleetAddresses sets the FleetAddresses shown in the fleet overview associated with the app.
+func (a *App) SetFleetAddresses(value []string) {
+	a.Preferences().SetStringList(FleetAddressesKey, value)
+}
+
+// FleetSort returns the FleetSort column associated with the app.
+func (a *App) FleetSort() string {
+	return a.Preferences().String(FleetSortKey)
+}
+
+// SetFleetSort sets the FleetSort column associated with the app.
+func (a *App) SetFleetSort(value string) {
+	a.Preferences().SetString(FleetSortKey, value)
+}
+
 // CacheFile returns the cache file for the given file name.
 func (a *App) CacheFile(fileName string) (fyne.URI, error) {
 	cacheFile, err := storage.Child(a.Storage().RootURI(), fileName)
@@ -1,7 +1,9 @@
 package format
 
 import (
+	"fmt"
 	"os"
+	"time"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
@@ -34,3 +36,30 @@ func FloatShort(f float64) string {
 func Int(i int64) string {
 	return printer.Sprintf("%d", i)
 }
+
+// Fiat formats a fiat amount as a string with 2 decimal places.
+func Fiat(f float64) string {
+	return printer.Sprintf("%.2f", f)
+}
+
+// Percent formats a ratio as a percentage string.
+func Percent(f float64) string {
+	return printer.Sprintf("%.1f%%", f*100)
+}
+
+// Duration formats a duration as a short string such as "2d 4h" or "5h 12m".
+func Duration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := int64(d / (24 * time.Hour))
+	hours := int64(d % (24 * time.Hour) / time.Hour)
+	minutes := int64(d % time.Hour / time.Minute)
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
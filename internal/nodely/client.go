@@ -9,6 +9,9 @@ import (
 // baseURL is the base URL for the archival node API.
 const baseURL = "https://mainnet-api.4160.nodely.dev"
 
+// GenesisID is the genesis ID of the network the API serves by default.
+const GenesisID = "mainnet-v1.0"
+
 // Client represents an HTTP client for the archival node API.
 type Client struct {
 	BaseURL string
@@ -0,0 +1,90 @@
+package price
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVSource represents a local CSV file of price history.
+//
+// Each row holds a date and a price, such as "2024-06-01,0.16". Extra
+// columns are ignored. The file holds a single currency, which a header row
+// may name in the price column, such as "date,usd". A file in another
+// currency than the selected one is rejected rather than labelled with the
+// wrong currency. Dates are UTC days, and times and unix timestamps are
+// keyed by their UTC date, like the prices of the HTTP source.
+type CSVSource struct {
+	Path string
+}
+
+// History returns the price history of the CSV file.
+func (s *CSVSource) History(currency string) (History, error) {
+	file, err := os.Open(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(History)
+	for _, record := range records {
+		if len(record) < 2 {
+			continue
+		}
+		date, ok := parseDate(strings.TrimSpace(record[0]))
+		if !ok {
+			if fileCurrency := headerCurrency(record[1]); fileCurrency != "" && fileCurrency != strings.ToLower(currency) {
+				return nil, fmt.Errorf("price file is in %s, not %s", strings.ToUpper(fileCurrency), strings.ToUpper(currency))
+			}
+			continue // Header or malformed row
+		}
+		price, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			continue
+		}
+		history[date] = price
+	}
+
+	return history, nil
+}
+
+// headerCurrency returns the currency named by a header column, or an empty string if it is not a currency code.
+func headerCurrency(column string) string {
+	column = strings.ToLower(strings.TrimSpace(column))
+	if len(column) != 3 {
+		return ""
+	}
+	for _, r := range column {
+		if r < 'a' || r > 'z' {
+			return ""
+		}
+	}
+	return column
+}
+
+// parseDate returns the UTC date (YYYY-MM-DD) of a date, RFC 3339 time or unix timestamp.
+func parseDate(s string) (string, bool) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t.Format("2006-01-02"), true
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC().Format("2006-01-02"), true
+	}
+	if ts, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if ts > 1e11 {
+			ts /= 1000 // Milliseconds
+		}
+		return time.Unix(ts, 0).UTC().Format("2006-01-02"), true
+	}
+	return "", false
+}
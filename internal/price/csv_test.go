@@ -0,0 +1,58 @@
+package price
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeCSV writes the content to a CSV file in a temporary directory and returns its path.
+func writeCSV(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCSVSourceHistory(t *testing.T) {
+	path := writeCSV(t, "date,price\n2024-06-01,0.16\n1717286400,0.17\n")
+
+	history, err := (&CSVSource{Path: path}).History("usd")
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	if history["2024-06-01"] != 0.16 || history["2024-06-02"] != 0.17 {
+		t.Errorf("History() = %v, want both rows", history)
+	}
+}
+
+func TestCSVSourceHistoryCurrency(t *testing.T) {
+	path := writeCSV(t, "date,eur\n2024-06-01,0.15\n")
+
+	if _, err := (&CSVSource{Path: path}).History("EUR"); err != nil {
+		t.Errorf("History(EUR) error = %v, want the matching currency accepted", err)
+	}
+	if _, err := (&CSVSource{Path: path}).History("usd"); err == nil {
+		t.Error("History(usd) error = nil, want the currency mismatch rejected")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"2024-06-01", "2024-06-01"},
+		{"2024-06-01T23:30:00-05:00", "2024-06-02"},
+		{"2024-06-02T01:00:00+09:00", "2024-06-01"},
+		{"1717286400", "2024-06-02"},
+		{"1717286400000", "2024-06-02"},
+	}
+	for _, tt := range tests {
+		if got, ok := parseDate(tt.s); !ok || got != tt.want {
+			t.Errorf("parseDate(%q) = %q, %v, want %q", tt.s, got, ok, tt.want)
+		}
+	}
+}
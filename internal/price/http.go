@@ -0,0 +1,61 @@
+package price
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTPSource represents an HTTP endpoint of price history.
+//
+// The URL may contain a {currency} placeholder. The response must be JSON
+// in the shape of the CoinGecko market chart: {"prices": [[ms, price], ...]}.
+type HTTPSource struct {
+	URL string
+}
+
+// marketChart represents a market chart response.
+type marketChart struct {
+	Prices [][2]float64 `json:"prices"`
+}
+
+// History returns the price history of the HTTP endpoint.
+func (s *HTTPSource) History(currency string) (History, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	req, err := http.NewRequest(http.MethodGet, strings.ReplaceAll(s.URL, "{currency}", currency), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("accept", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price source returned %s", res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var chart marketChart
+	if err := json.Unmarshal(body, &chart); err != nil {
+		return nil, err
+	}
+
+	// Daily prices are stamped at 00:00 UTC, so they are keyed by their UTC
+	// date rather than shifted to the day before or after in local time. Later
+	// prices of the same day replace earlier ones.
+	history := make(History)
+	for _, point := range chart.Prices {
+		date := time.UnixMilli(int64(point[0])).UTC().Format("2006-01-02")
+		history[date] = point[1]
+	}
+
+	return history, nil
+}
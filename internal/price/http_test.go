@@ -0,0 +1,44 @@
+package price
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPSourceHistory(t *testing.T) {
+	var currency string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		currency = r.URL.Query().Get("vs_currency")
+		_, _ = w.Write([]byte(`{"prices":[[1717200000000,0.16],[1717286400000,0.17]]}`))
+	}))
+	defer server.Close()
+
+	// Keyed by UTC date whatever the local timezone
+	local := time.Local
+	time.Local = time.FixedZone("UTC-8", -8*60*60)
+	defer func() { time.Local = local }()
+
+	history, err := (&HTTPSource{URL: server.URL + "/?vs_currency={currency}"}).History("eur")
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	if currency != "eur" {
+		t.Errorf("requested currency = %q, want %q", currency, "eur")
+	}
+	if history["2024-06-01"] != 0.16 || history["2024-06-02"] != 0.17 {
+		t.Errorf("History() = %v, want prices keyed by UTC date", history)
+	}
+}
+
+func TestHTTPSourceHistoryStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	if _, err := (&HTTPSource{URL: server.URL}).History("usd"); err == nil {
+		t.Error("History() error = nil, want the status")
+	}
+}
@@ -0,0 +1,132 @@
+package price
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+// DefaultCurrency is the currency used when none is selected.
+const DefaultCurrency = "usd"
+
+// DefaultURL is the default price history URL. {currency} is replaced by the selected currency.
+//
+// The public API only serves the last 365 days, so older payouts have no
+// price unless a CSV file or another endpoint with the full history is used.
+//
+// Docs: https://docs.coingecko.com/reference/coins-id-market-chart
+const DefaultURL = "https://api.coingecko.com/api/v3/coins/algorand/market_chart?vs_currency={currency}&days=365&interval=daily"
+
+// cacheDuration is how long a fetched price history is reused.
+const cacheDuration = time.Hour
+
+// maxPriceAge is the number of days an older price is used for a date without a price.
+const maxPriceAge = 7
+
+// Source represents a source of historical ALGO prices.
+type Source interface {
+	History(currency string) (History, error)
+}
+
+// History represents the price of one ALGO keyed by UTC date (YYYY-MM-DD).
+type History map[string]float64
+
+// At returns the price of one ALGO at the given time and whether the history
+// has a price for it.
+//
+// The time is looked up by its UTC date, the day the prices are keyed by,
+// whatever location it is shown in.
+func (h History) At(t time.Time) (float64, bool) {
+	return h.Lookup(t.UTC().Format("2006-01-02"))
+}
+
+// Lookup returns the price of one ALGO on the given UTC date (YYYY-MM-DD) and
+// whether the history has a price for it, so callers can tell a missing
+// price from a price of zero.
+//
+// Dates without a price use the most recent price of the week before.
+func (h History) Lookup(date string) (float64, bool) {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return 0, false
+	}
+	for i := 0; i <= maxPriceAge; i++ {
+		if price, ok := h[t.AddDate(0, 0, -i).Format("2006-01-02")]; ok {
+			return price, true
+		}
+	}
+	return 0, false
+}
+
+// CurrentSource returns the price source selected in the app, or nil if none is selected.
+func CurrentSource() Source {
+	a := app.CurrentApp()
+	switch a.PriceSource() {
+	case "csv":
+		return &CSVSource{Path: a.PriceFile()}
+	case "http":
+		url := a.PriceURL()
+		if url == "" {
+			url = DefaultURL
+		}
+		return &HTTPSource{URL: url}
+	default:
+		return nil
+	}
+}
+
+// Currency returns the currency selected in the app.
+func Currency() string {
+	if currency := app.CurrentApp().Currency(); currency != "" {
+		return strings.ToLower(currency)
+	}
+	return DefaultCurrency
+}
+
+// cache holds the last fetched price history.
+var cache struct {
+	sync.Mutex
+	key     string
+	history History
+	fetched time.Time
+	err     error
+}
+
+// FetchHistory returns the price history of the selected source and currency.
+//
+// It returns nil if no source is selected or the history cannot be fetched,
+// in which case LastError returns the error. Histories are reused for an hour
+// so views can be rendered without refetching.
+func FetchHistory() History {
+	source := CurrentSource()
+
+	cache.Lock()
+	defer cache.Unlock()
+	if source == nil {
+		cache.err = nil
+		return nil
+	}
+
+	a := app.CurrentApp()
+	key := strings.Join([]string{a.PriceSource(), a.PriceFile(), a.PriceURL(), Currency()}, "|")
+	if cache.key == key && time.Since(cache.fetched) < cacheDuration {
+		return cache.history
+	}
+
+	history, err := source.History(Currency())
+	cache.err = err
+	if err != nil {
+		return nil
+	}
+	cache.key, cache.history, cache.fetched = key, history, time.Now()
+	return history
+}
+
+// LastError returns the error of the last price history fetch, or nil if it succeeded.
+func LastError() error {
+	cache.Lock()
+	defer cache.Unlock()
+	return cache.err
+}
@@ -0,0 +1,34 @@
+package price
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHistoryAt(t *testing.T) {
+	history := History{"2026-10-01": 1, "2026-10-02": 2}
+	newYork := time.FixedZone("EST", -5*60*60)
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want float64
+		ok   bool
+	}{
+		{"utc", time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), 1, true},
+		{"late evening west of utc", time.Date(2026, 10, 1, 22, 0, 0, 0, newYork), 2, true},
+		{"early morning east of utc", time.Date(2026, 10, 2, 6, 0, 0, 0, tokyo), 1, true},
+		{"week old price", time.Date(2026, 10, 9, 12, 0, 0, 0, time.UTC), 2, true},
+		{"missing", time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC), 0, false},
+		{"before the history", time.Date(2026, 9, 30, 12, 0, 0, 0, time.UTC), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := history.At(tt.t)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("At(%s) = %g, %v, want %g, %v", tt.t, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
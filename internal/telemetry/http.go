@@ -0,0 +1,51 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPClient represents an HTTP endpoint of node telemetry.
+//
+// The URL may contain a {guid} placeholder. The response must be the JSON
+// encoding of a Node.
+type HTTPClient struct {
+	URL string
+}
+
+// Node returns the telemetry of the node with the given GUID.
+func (c *HTTPClient) Node(guid string) (*Node, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	req, err := http.NewRequest(http.MethodGet, strings.ReplaceAll(c.URL, "{guid}", url.PathEscape(guid)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("accept", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("telemetry returned %s", res.Status)
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var node Node
+	if err := json.Unmarshal(body, &node); err != nil {
+		return nil, err
+	}
+	if node.GUID == "" {
+		node.GUID = guid
+	}
+
+	return &node, nil
+}
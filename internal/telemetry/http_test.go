@@ -0,0 +1,63 @@
+package telemetry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTPClientNode(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"node-1","synced":true,"round":42,"peers":{"incoming":3,"outgoing":4}}`))
+	}))
+	defer server.Close()
+
+	client := &HTTPClient{URL: server.URL + "/nodes/{guid}"}
+	node, err := client.Node("abc/123")
+	if err != nil {
+		t.Fatalf("Node() error = %v", err)
+	}
+	if path != "/nodes/abc%2F123" {
+		t.Errorf("requested path = %q, want %q", path, "/nodes/abc%2F123")
+	}
+	if node.GUID != "abc/123" {
+		t.Errorf("GUID = %q, want the requested GUID", node.GUID)
+	}
+	if node.Name != "node-1" || !node.Synced || node.Round != 42 || node.Peers.Total() != 7 {
+		t.Errorf("Node() = %+v, want the decoded response", node)
+	}
+}
+
+func TestHTTPClientNodeKeepsGUID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"guid":"reported"}`))
+	}))
+	defer server.Close()
+
+	node, err := (&HTTPClient{URL: server.URL + "/{guid}"}).Node("requested")
+	if err != nil {
+		t.Fatalf("Node() error = %v", err)
+	}
+	if node.GUID != "reported" {
+		t.Errorf("GUID = %q, want the reported GUID", node.GUID)
+	}
+}
+
+func TestHTTPClientNodeStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	node, err := (&HTTPClient{URL: server.URL + "/{guid}"}).Node("abc")
+	if err == nil {
+		t.Fatalf("Node() = %+v, want an error", node)
+	}
+	if !strings.Contains(err.Error(), "404") {
+		t.Errorf("error = %v, want the status", err)
+	}
+}
@@ -0,0 +1,94 @@
+package telemetry
+
+import (
+	"errors"
+	"time"
+
+	"github.com/calmdev/algorand-rewards/internal/app"
+)
+
+// URLPlaceholder is the example telemetry URL shown in the settings. {guid} is replaced by the telemetry GUID.
+//
+// There is no default URL, as nodely does not document a public telemetry API.
+const URLPlaceholder = "https://example.com/telemetry/{guid}"
+
+var (
+	// ErrNoGUID is returned when no telemetry GUID is configured.
+	ErrNoGUID = errors.New("no telemetry GUID configured")
+
+	// ErrNoURL is returned when no telemetry URL is configured.
+	ErrNoURL = errors.New("no telemetry URL configured")
+)
+
+// Client represents a source of node telemetry.
+type Client interface {
+	Node(guid string) (*Node, error)
+}
+
+// Node represents the telemetry of a node.
+type Node struct {
+	GUID        string  `json:"guid"`
+	Name        string  `json:"name"`
+	Version     string  `json:"version"`
+	Synced      bool    `json:"synced"`
+	Round       int64   `json:"round"`
+	CatchupTime int64   `json:"catchup-time"` // Nanoseconds
+	Peers       Peers   `json:"peers"`
+	Uptime      int64   `json:"uptime"`    // Seconds
+	LastSeen    int64   `json:"last-seen"` // Unix timestamp
+	Errors      []Event `json:"errors"`    // Newest first
+}
+
+// Peers represents the peer connections of a node.
+type Peers struct {
+	Incoming int `json:"incoming"`
+	Outgoing int `json:"outgoing"`
+}
+
+// Total returns the total number of peers.
+func (p Peers) Total() int {
+	return p.Incoming + p.Outgoing
+}
+
+// Event represents a telemetry event reported by a node.
+type Event struct {
+	Timestamp int64  `json:"timestamp"` // Unix timestamp
+	Severity  string `json:"severity"`
+	Message   string `json:"message"`
+}
+
+// Time returns the timestamp as a time.Time.
+func (e *Event) Time() time.Time {
+	return time.Unix(e.Timestamp, 0)
+}
+
+// UptimeDuration returns the uptime as a time.Duration.
+func (n *Node) UptimeDuration() time.Duration {
+	return time.Duration(n.Uptime) * time.Second
+}
+
+// LastSeenTime returns the last time the node reported telemetry.
+func (n *Node) LastSeenTime() time.Time {
+	return time.Unix(n.LastSeen, 0)
+}
+
+// CurrentClient returns the telemetry client selected in the app, or nil if no URL is configured.
+func CurrentClient() Client {
+	url := app.CurrentApp().TelemetryURL()
+	if url == "" {
+		return nil
+	}
+	return &HTTPClient{URL: url}
+}
+
+// FetchNode returns the telemetry of the node with the GUID selected in the app.
+func FetchNode(client Client) (*Node, error) {
+	guid := app.CurrentApp().GUID()
+	if guid == "" {
+		return nil, ErrNoGUID
+	}
+	if client == nil {
+		return nil, ErrNoURL
+	}
+	return client.Node(guid)
+}
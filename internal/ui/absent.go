@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+)
+
+// AbsenteeismMessage returns the suspension risk warning, or an empty string if none.
+func AbsenteeismMessage(absent *algo.Absenteeism) string {
+	switch {
+	case absent == nil || !absent.AtRisk():
+		return ""
+	case absent.Absent():
+		return "Account may be suspended as absent"
+	default:
+		return fmt.Sprintf("Absent in %s without a proposal or heartbeat", format.Duration(absent.TimeLeft()))
+	}
+}
+
+// absenteeismColor returns the color of the suspension risk.
+func absenteeismColor(absent *algo.Absenteeism) color.Color {
+	switch {
+	case absent == nil || !absent.AtRisk():
+		return DarkGreen
+	case absent.Critical():
+		return DarkRed
+	default:
+		return Amber
+	}
+}
+
+// AbsenteeismWarning returns the suspension risk warning for the header, or nil if none.
+func AbsenteeismWarning(absent *algo.Absenteeism) fyne.CanvasObject {
+	message := AbsenteeismMessage(absent)
+	if message == "" {
+		return nil
+	}
+
+	icon := widget.NewIcon(theme.NewWarningThemedResource(theme.WarningIcon()))
+	if absent.Critical() {
+		icon = widget.NewIcon(theme.NewErrorThemedResource(theme.WarningIcon()))
+	}
+	text := canvas.NewText(message, absenteeismColor(absent))
+	text.TextStyle.Bold = true
+	text.TextSize = 12
+
+	return container.NewHBox(icon, container.NewCenter(text))
+}
+
+// AlertAbsenteeism shows the suspension risk warning in the system tray and
+// sends a desktop notification once when the account becomes at risk and
+// again when suspension is imminent.
+func AlertAbsenteeism(a *app.App, absent *algo.Absenteeism) {
+	message := AbsenteeismMessage(absent)
+	setTrayNotice(a, "absent", message)
+
+	if message == "" {
+		return
+	}
+
+	// Notify once per last seen round and level
+	level := "warning"
+	if absent.Critical() {
+		level = "critical"
+	}
+	notified := fmt.Sprintf("%d:%s", absent.LastSeen, level)
+	if a.AbsentNotified() == notified {
+		return
+	}
+	a.SetAbsentNotified(notified)
+	a.SendNotification(fyne.NewNotification(app.AppName, message))
+}
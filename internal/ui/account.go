@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"encoding/base64"
+	"fmt"
+	"image/color"
+	"net/url"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// maxOutOfBoundsDays is the most days out of the stake bounds listed.
+const maxOutOfBoundsDays = 30
+
+// AccountDetails returns the balances, status, suspension risk, stake eligibility and participation key of an account.
+func AccountDetails(account *algo.Account, absent *algo.Absenteeism, check *algo.EligibilityCheck) fyne.CanvasObject {
+	// createTitle creates a new title for a section.
+	createTitle := func(title string) fyne.CanvasObject {
+		text := canvas.NewText(title, Grey)
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+		return container.NewVBox(text, widget.NewSeparator())
+	}
+
+	// createRow creates a new row with a label and a value.
+	createRow := func(label string, value fyne.CanvasObject) *fyne.Container {
+		text := iw.NewColorLabel(label, theme.Color(theme.ColorNameForeground))
+		text.SetMinWidth(160)
+		text.SetTextStyle(fyne.TextStyle{Bold: true})
+		return container.NewHBox(text, value)
+	}
+
+	// createValue creates a new value for a row.
+	createValue := func(value string, c color.Color) *iw.ColorLabel {
+		return iw.NewColorLabel(value, c)
+	}
+
+	// createKey creates a new shortened key value with a copy button.
+	createKey := func(key []byte) fyne.CanvasObject {
+		if len(key) == 0 {
+			return createValue("-", Grey)
+		}
+		encoded := base64.StdEncoding.EncodeToString(key)
+		copyButton := widget.NewButtonWithIcon("", theme.ContentCopyIcon(), func() {
+			Layout.window.Clipboard().SetContent(encoded)
+		})
+		copyButton.Importance = widget.LowImportance
+		return container.NewHBox(createValue(format.AddressShort(encoded), theme.Color(theme.ColorNameForeground)), copyButton)
+	}
+
+	// createRound creates a new round value linked to allo.info.
+	createRound := func(round int64) fyne.CanvasObject {
+		if round == 0 {
+			return createValue("-", Grey)
+		}
+		link := iw.NewHyperlink(format.Int(round), &url.URL{
+			Scheme: "https",
+			Host:   "allo.info",
+			Path:   fmt.Sprintf("/block/%d", round),
+		})
+		link.TextSize = theme.TextSize()
+		link.Color = theme.Color(theme.ColorNameHyperlink)
+		return container.NewCenter(link)
+	}
+
+	// createBool creates a new yes or no value.
+	createBool := func(ok bool) *iw.ColorLabel {
+		if ok {
+			return createValue("Yes", DarkGreen)
+		}
+		return createValue("No", DarkRed)
+	}
+
+	foreground := theme.Color(theme.ColorNameForeground)
+
+	// Balances
+	balances := container.NewVBox(
+		createTitle("Balances"),
+		createRow("Balance:", createValue(format.Float(account.AlgoBalance()), foreground)),
+		createRow("Minimum Balance:", createValue(format.Float(account.AlgoMinBalance()), foreground)),
+		createRow("Pending Rewards:", createValue(format.Float(account.AlgoPendingRewards()), foreground)),
+		createRow("Total Rewards:", createValue(format.Float(account.AlgoRewards()), foreground)),
+		createRow("Round:", createRound(account.Round)),
+	)
+
+	// Status
+	statusColor := DarkRed
+	if account.Online() {
+		statusColor = DarkGreen
+	}
+	authAddr := createValue("Not rekeyed", Grey)
+	if account.Rekeyed() {
+		authAddr = createValue(format.AddressShort(account.AuthAddr), foreground)
+	}
+	status := container.NewVBox(
+		createTitle("Status"),
+		createRow("Status:", createValue(account.Status, statusColor)),
+		createRow("Incentive Eligible:", createBool(account.IncentiveEligible)),
+		createRow("Last Proposed:", createRound(account.LastProposed)),
+		createRow("Last Heartbeat:", createRound(account.LastHeartbeat)),
+		createRow("Auth Address:", authAddr),
+	)
+
+	// Suspension risk
+	absenteeism := container.NewVBox(createTitle("Suspension Risk"))
+	if absent != nil {
+		progress := widget.NewProgressBar()
+		progress.SetValue(absent.Progress())
+		progress.TextFormatter = func() string {
+			return fmt.Sprintf("%s of %s rounds", format.Int(absent.RoundsSinceSeen()), format.Int(absent.AllowedRounds))
+		}
+
+		risk := "OK"
+		switch {
+		case absent.Absent():
+			risk = "Absent"
+		case absent.Critical():
+			risk = "Suspension imminent"
+		case absent.AtRisk():
+			risk = "At risk"
+		}
+
+		absenteeism.Add(createRow("Expected Interval:", createValue(fmt.Sprintf("%s rounds (%s)", format.Int(int64(absent.ExpectedInterval)), format.Duration(absent.ExpectedTime())), foreground)))
+		absenteeism.Add(createRow("Last Seen:", createValue(fmt.Sprintf("%s rounds ago", format.Int(absent.RoundsSinceSeen())), foreground)))
+		absenteeism.Add(createRow("Absent In:", createValue(fmt.Sprintf("%s rounds (%s)", format.Int(absent.RoundsLeft()), format.Duration(absent.TimeLeft())), foreground)))
+		absenteeism.Add(createRow("Risk:", createValue(risk, absenteeismColor(absent))))
+		absenteeism.Add(progress)
+	} else {
+		absenteeism.Add(createValue("Only online accounts can be suspended.", Grey))
+	}
+
+	// Stake eligibility
+	eligibility := container.NewVBox(createTitle("Stake Eligibility"))
+	if check != nil {
+		eligibility.Add(createRow("Bounds:", createValue(fmt.Sprintf("%s - %s ALGO", format.FloatShort(check.Bounds.AlgoMin()), format.FloatShort(check.Bounds.AlgoMax())), foreground)))
+		eligibility.Add(createRow("Balance:", createValue(format.Float(check.AlgoBalance()), stakeColor(check.Status()))))
+		eligibility.Add(createRow(fmt.Sprintf("Projected (%dd):", check.ProjectionDays), createValue(format.Float(check.AlgoProjected()), stakeColor(check.ProjectedStatus()))))
+		if message := EligibilityMessage(check); message != "" {
+			eligibility.Add(createValue(message, stakeColor(check.Status())))
+		}
+		if len(check.OutOfBounds) == 0 {
+			eligibility.Add(createRow("Out of Bounds:", createValue("Never", DarkGreen)))
+		} else {
+			eligibility.Add(createRow("Out of Bounds:", createValue(fmt.Sprintf("%d days", len(check.OutOfBounds)), DarkRed)))
+			days := container.NewGridWithColumns(3)
+			for _, day := range check.OutOfBounds[:min(len(check.OutOfBounds), maxOutOfBoundsDays)] {
+				days.Add(createValue(fmt.Sprintf("%s  (low %s)", day.Date, format.FloatShort(day.AlgoMin())), Grey))
+			}
+			eligibility.Add(days)
+			if more := len(check.OutOfBounds) - maxOutOfBoundsDays; more > 0 {
+				eligibility.Add(createValue(fmt.Sprintf("and %d earlier days", more), Grey))
+			}
+		}
+	} else {
+		eligibility.Add(createValue("Balance history unavailable.", Grey))
+	}
+
+	// Participation key
+	participation := container.NewVBox(createTitle("Participation Key"))
+	if p := account.Participation; p != nil {
+		participation.Add(createRow("Selection Key:", createKey(p.SelectionKey)))
+		participation.Add(createRow("Vote Key:", createKey(p.VoteKey)))
+		participation.Add(createRow("State Proof Key:", createKey(p.StateProofKey)))
+		participation.Add(createRow("Vote First Valid:", createRound(p.VoteFirstValid)))
+		participation.Add(createRow("Vote Last Valid:", createRound(p.VoteLastValid)))
+		participation.Add(createRow("Key Dilution:", createValue(format.Int(p.VoteKeyDilution), foreground)))
+	} else {
+		participation.Add(createValue("No participation key registered.", Grey))
+	}
+
+	return container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewVBox(
+		container.NewGridWithColumns(2, balances, status),
+		absenteeism,
+		eligibility,
+		participation,
+	)))
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"fmt"
+	"net/url"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// BlocksList returns a list of the blocks proposed in a period.
+func BlocksList(period string, blocks []algo.BlockHeader) fyne.CanvasObject {
+	var l *appLayout
+	var offset int
+	var content *fyne.Container
+
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		return label
+	}
+
+	// createBlockItem creates a new block item.
+	createBlockItem := func(block algo.BlockHeader) *fyne.Container {
+		round := iw.NewHyperlink(fmt.Sprintf("%d", block.Round), &url.URL{
+			Scheme: "https",
+			Host:   "allo.info",
+			Path:   fmt.Sprintf("/block/%d", block.Round),
+		})
+		round.TextSize = theme.TextSize()
+		round.Color = theme.Color(theme.ColorNameHyperlink)
+		roundCell := container.New(layout.NewGridWrapLayout(fyne.NewSize(100, round.MinSize().Height+10)),
+			container.NewHBox(container.NewCenter(round)),
+		)
+
+		return container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), container.NewHBox(
+			roundCell,
+			iw.NewColorLabel(block.Time().Format("2006-01-02 03:04:05 PM"), Grey),
+			layout.NewSpacer(),
+			createCellLabel(format.Float(block.AlgoFeesCollected()), 110),
+			createCellLabel(format.Float(block.AlgoBonus()), 110),
+			AlgoIcon(10),
+			createCellLabel(format.Float(block.AlgoPayout()), 110),
+		))
+	}
+
+	// loadMore loads more blocks.
+	loadMore := func() {
+		end := min(offset+batchSize, len(blocks))
+		for _, block := range blocks[offset:end] {
+			content.Add(createBlockItem(block))
+			content.Add(widget.NewSeparator())
+		}
+		offset = end
+	}
+
+	// Add sticky header
+	back := widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		RenderView(&RewardsView{})
+	})
+	back.Importance = widget.LowImportance
+
+	title := canvas.NewText(fmt.Sprintf("%s: %s Blocks", period, format.Int(int64(len(blocks)))), theme.Color(theme.ColorNameForeground))
+	title.TextStyle.Bold = true
+
+	header := container.NewHBox(
+		createHeaderLabel("Round", 100),
+		createHeaderLabel("Time", 160),
+		layout.NewSpacer(),
+		createHeaderLabel("Fees Collected", 110),
+		createHeaderLabel("Bonus", 110),
+		createHeaderLabel("Payout", 120),
+	)
+
+	content = container.NewVBox()
+	scroll := container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), content))
+	scroll.OnScrolled = func(p fyne.Position) {
+		// Load more blocks when near the bottom.
+		if p.Y > scroll.Content.Size().Height-scroll.Size().Height-100 {
+			loadMore()
+		}
+	}
+
+	l = newAppLayout()
+	l.topBar = container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), container.NewVBox(
+		container.NewHBox(back, title),
+		container.New(layout.NewCustomPaddedLayout(0, 0, 5, 10), header),
+	))
+	l.mainContent = scroll
+	l.container = l.render()
+
+	loadMore() // Initial load
+
+	return l.container
+}
@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// BreakdownList returns the bonus and fee share of rewards with the bonus decay chart.
+func BreakdownList(b *algo.Breakdown, luck *algo.Luck) fyne.CanvasObject {
+	// createText creates a new text for the breakdown summary.
+	createText := func(label, value string, c color.Color) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, c)
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, c)
+		label.SetMinWidth(width)
+		return label
+	}
+
+	// createShareBar creates a bar split between the bonus and fee share.
+	createShareBar := func(row algo.BreakdownRow, width float32) fyne.CanvasObject {
+		bonus := canvas.NewRectangle(theme.Color(theme.ColorNamePrimary))
+		bonus.SetMinSize(fyne.NewSize(width*float32(row.BonusShare), 8))
+		fees := canvas.NewRectangle(DarkGreen)
+		fees.SetMinSize(fyne.NewSize(width*float32(row.FeeShare), 8))
+		return container.NewCenter(container.New(layout.NewHBoxLayout(), bonus, fees))
+	}
+
+	// createLegend creates a new legend entry for a chart series.
+	createLegend := func(label string, c color.Color) *canvas.Text {
+		text := canvas.NewText("— "+label, c)
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+		return text
+	}
+
+	// Summary of the shares and the bonus schedule
+	summary := container.NewHBox(
+		createText("Bonus Share: ", format.Percent(b.BonusShare), theme.Color(theme.ColorNamePrimary)),
+		layout.NewSpacer(),
+		createText("Fee Share: ", format.Percent(b.FeeShare), DarkGreen),
+	)
+	if n := len(b.Points); n > 0 {
+		last := b.Points[n-1]
+		summary.Add(layout.NewSpacer())
+		summary.Add(createText("Bonus/Block: ", format.FloatShort(last.Bonus)+" ("+format.FloatShort(last.Scheduled)+" scheduled)", theme.Color(theme.ColorNameForeground)))
+	}
+	if luck != nil && luck.Round > 0 {
+		nextDecay := time.Duration(algo.MainnetBonusPlan.RoundsToNextDecay(luck.Round)) * luck.RoundTime
+		summary.Add(layout.NewSpacer())
+		summary.Add(createText("Next Decay: ", format.Duration(nextDecay), theme.Color(theme.ColorNameForeground)))
+	}
+
+	// Bonus per block against the scheduled decay
+	var actual, scheduled []float64
+	var labels []string
+	for i, point := range b.Points {
+		actual = append(actual, point.Bonus)
+		scheduled = append(scheduled, point.Scheduled)
+		if i == 0 || i == len(b.Points)-1 || i == len(b.Points)/2 {
+			labels = append(labels, point.Date)
+		}
+	}
+	chart := iw.NewLineChart([][]float64{scheduled, actual}, []color.Color{Grey, theme.Color(theme.ColorNamePrimary)}, 90)
+	chart.SetLabels(labels, Grey)
+
+	legend := container.NewHBox(
+		createLegend("Bonus per Block", theme.Color(theme.ColorNamePrimary)),
+		createLegend("Scheduled Bonus", Grey),
+	)
+
+	// Share of each period
+	header := container.NewHBox(
+		createHeaderLabel("Date", 120),
+		createHeaderLabel("Bonus", 110),
+		createHeaderLabel("Fees", 110),
+		createHeaderLabel("Bonus %", 80),
+		createHeaderLabel("Fee %", 80),
+		createHeaderLabel("Share", 160),
+	)
+	rows := container.NewVBox(header)
+	for _, row := range b.Rows {
+		rows.Add(widget.NewSeparator())
+		rows.Add(container.NewHBox(
+			createCellLabel(row.Date, Grey, 120),
+			createCellLabel(format.Float(row.Bonus), theme.Color(theme.ColorNameForeground), 110),
+			createCellLabel(format.Float(row.Fees), theme.Color(theme.ColorNameForeground), 110),
+			createCellLabel(format.Percent(row.BonusShare), theme.Color(theme.ColorNameForeground), 80),
+			createCellLabel(format.Percent(row.FeeShare), theme.Color(theme.ColorNameForeground), 80),
+			createShareBar(row, 150),
+		))
+	}
+
+	return container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewVBox(
+		summary,
+		legend,
+		chart,
+		widget.NewSeparator(),
+		rows,
+	)))
+}
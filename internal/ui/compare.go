@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// comparisonModeLabels are the labels of the comparison modes.
+var comparisonModeLabels = map[string]string{
+	"month":   "Month to Date vs Same Days Last Month",
+	"quarter": "Quarter to Date vs Same Days Last Year",
+	"year":    "Year to Date vs Same Days Last Year",
+	"custom":  "Custom Ranges",
+}
+
+// ComparisonList returns a period-over-period comparison of rewards.
+func ComparisonList(r *algo.Rewards) fyne.CanvasObject {
+	var comparison *algo.Comparison
+	var table *fyne.Container
+
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, c)
+		label.SetMinWidth(width)
+		return label
+	}
+
+	// createDateEntry creates a new entry for a date.
+	createDateEntry := func(date time.Time) *widget.Entry {
+		entry := widget.NewEntry()
+		entry.SetPlaceHolder("YYYY-MM-DD")
+		entry.SetText(date.Format("2006-01-02"))
+		entry.Validator = func(s string) error {
+			_, err := time.Parse("2006-01-02", s)
+			return err
+		}
+		return entry
+	}
+
+	// Custom ranges default to the last 30 days and the 30 days before.
+	now := time.Now()
+	previousStart := createDateEntry(now.AddDate(0, 0, -59))
+	previousEnd := createDateEntry(now.AddDate(0, 0, -30))
+	currentStart := createDateEntry(now.AddDate(0, 0, -29))
+	currentEnd := createDateEntry(now)
+	customRanges := container.NewGridWithColumns(4, previousStart, previousEnd, currentStart, currentEnd)
+	customRanges.Hide()
+
+	// renderTable renders the comparison table.
+	renderTable := func() {
+		table.RemoveAll()
+		if comparison == nil {
+			table.Refresh()
+			return
+		}
+		table.Add(container.NewHBox(
+			createHeaderLabel("Metric", 120),
+			createHeaderLabel(comparison.Previous.Label, 150),
+			createHeaderLabel(comparison.Current.Label, 150),
+			createHeaderLabel("Change", 140),
+			createHeaderLabel("Change %", 80),
+		))
+		for _, row := range comparison.Rows() {
+			changeColor := theme.Color(theme.ColorNameForeground)
+			if row.Delta > 0 {
+				changeColor = DarkGreen
+			} else if row.Delta < 0 {
+				changeColor = DarkRed
+			}
+
+			table.Add(widget.NewSeparator())
+			table.Add(container.NewHBox(
+				createCellLabel(row.Metric, Grey, 120),
+				createCellLabel(row.FormatValue(row.Previous), theme.Color(theme.ColorNameForeground), 150),
+				createCellLabel(row.FormatValue(row.Current), theme.Color(theme.ColorNameForeground), 150),
+				createCellLabel(row.FormatValue(row.Delta), changeColor, 140),
+				createCellLabel(format.Percent(row.Change), changeColor, 80),
+			))
+		}
+		table.Refresh()
+	}
+
+	// compare compares the periods of the given mode.
+	compare := func(mode string) {
+		if mode == "custom" {
+			customRanges.Show()
+			for _, entry := range []*widget.Entry{previousStart, previousEnd, currentStart, currentEnd} {
+				if entry.Validate() != nil {
+					return
+				}
+			}
+			comparison = algo.NewRangeComparison(r, previousStart.Text, previousEnd.Text, currentStart.Text, currentEnd.Text)
+		} else {
+			customRanges.Hide()
+			comparison = algo.NewComparison(r, mode, time.Now())
+		}
+		renderTable()
+	}
+
+	// Comparison mode selector
+	var labels []string
+	for _, mode := range algo.ComparisonModes {
+		labels = append(labels, comparisonModeLabels[mode])
+	}
+	var mode *widget.Select
+	mode = widget.NewSelect(labels, func(label string) {
+		compare(algo.ComparisonModes[mode.SelectedIndex()])
+	})
+
+	// Recompare when a custom range changes
+	for _, entry := range []*widget.Entry{previousStart, previousEnd, currentStart, currentEnd} {
+		entry.OnChanged = func(string) {
+			compare("custom")
+		}
+	}
+
+	// Export button
+	export := widget.NewButtonWithIcon("Export", theme.DocumentSaveIcon(), func() {
+		ComparisonExportDialog(comparison, Layout.window)
+	})
+
+	table = container.NewVBox()
+	mode.SetSelectedIndex(0)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewBorder(
+		container.NewVBox(
+			container.NewBorder(nil, nil, nil, export, mode),
+			customRanges,
+		),
+		nil,
+		nil,
+		nil,
+		container.NewVScroll(container.New(layout.NewCustomPaddedLayout(5, 0, 5, 5), table)),
+	))
+}
+
+// ComparisonExportDialog opens a dialog to export a comparison.
+func ComparisonExportDialog(c *algo.Comparison, w fyne.Window) {
+	d := dialog.NewFileSave(
+		func(writer fyne.URIWriteCloser, err error) {
+			if err != nil {
+				return
+			}
+			if writer == nil {
+				return
+			}
+			defer writer.Close()
+			if c == nil {
+				return
+			}
+			algo.ExportComparison(c, writer)
+		},
+		w,
+	)
+	d.SetFileName("comparison.csv")
+	d.SetFilter(storage.NewExtensionFileFilter([]string{".csv"}))
+	d.SetView(dialog.ListView)
+	d.Resize(fyne.NewSize(MainWindowWidth-20, MainWindowHeight-20))
+	d.Show()
+}
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+)
+
+// StakeMessage returns the warning for a balance status against the stake bounds, or an empty string if none.
+func StakeMessage(status string, bounds algo.StakeBounds) string {
+	switch status {
+	case "below":
+		return fmt.Sprintf("Balance below the %s ALGO eligibility minimum", format.FloatShort(bounds.AlgoMin()))
+	case "above":
+		return fmt.Sprintf("Balance above the %s ALGO eligibility maximum", format.FloatShort(bounds.AlgoMax()))
+	case "nearMin":
+		return fmt.Sprintf("Balance close to the %s ALGO eligibility minimum", format.FloatShort(bounds.AlgoMin()))
+	case "nearMax":
+		return fmt.Sprintf("Balance close to the %s ALGO eligibility maximum", format.FloatShort(bounds.AlgoMax()))
+	default:
+		return ""
+	}
+}
+
+// EligibilityMessage returns the warning for the current or projected balance, or an empty string if none.
+func EligibilityMessage(check *algo.EligibilityCheck) string {
+	if check == nil {
+		return ""
+	}
+	if message := StakeMessage(check.Status(), check.Bounds); message != "" {
+		return message
+	}
+	switch check.ProjectedStatus() {
+	case "below":
+		return fmt.Sprintf("Balance projected below the %s ALGO eligibility minimum in %d days", format.FloatShort(check.Bounds.AlgoMin()), check.ProjectionDays)
+	case "above":
+		return fmt.Sprintf("Balance projected above the %s ALGO eligibility maximum in %d days", format.FloatShort(check.Bounds.AlgoMax()), check.ProjectionDays)
+	default:
+		return ""
+	}
+}
+
+// stakeColor returns the color of a balance status against the stake bounds.
+func stakeColor(status string) color.Color {
+	switch status {
+	case "below", "above":
+		return DarkRed
+	case "nearMin", "nearMax":
+		return Amber
+	default:
+		return DarkGreen
+	}
+}
+
+// StakeWarning returns the stake eligibility warning of an online account for the header, or nil if none.
+//
+// The bounds are those of the network resolved by the last account alert check.
+func StakeWarning(account *algo.Account) fyne.CanvasObject {
+	if !account.Online() {
+		return nil
+	}
+	bounds := algo.KnownStakeBounds()
+	status := bounds.Status(account.Amount)
+	message := StakeMessage(status, bounds)
+	if message == "" {
+		return nil
+	}
+
+	icon := widget.NewIcon(theme.NewWarningThemedResource(theme.WarningIcon()))
+	if stakeColor(status) == DarkRed {
+		icon = widget.NewIcon(theme.NewErrorThemedResource(theme.WarningIcon()))
+	}
+	text := canvas.NewText(message, stakeColor(status))
+	text.TextStyle.Bold = true
+	text.TextSize = 12
+
+	return container.NewHBox(icon, container.NewCenter(text))
+}
+
+// AlertEligibility shows the stake eligibility warning in the system tray and
+// sends a desktop notification once each time the warning changes.
+func AlertEligibility(a *app.App, account *algo.Account, check *algo.EligibilityCheck) {
+	if check == nil {
+		return
+	}
+
+	var message string
+	if account != nil && account.Online() {
+		message = EligibilityMessage(check)
+	}
+	setTrayNotice(a, "stake", message)
+
+	// Notify once per warning
+	if a.StakeNotified() == message {
+		return
+	}
+	a.SetStakeNotified(message)
+	if message != "" {
+		a.SendNotification(fyne.NewNotification(app.AppName, message))
+	}
+}
@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"net/url"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// FleetList returns the state and recent rewards of each account in the fleet, sorted by the selected column.
+func FleetList(a *app.App, entries []*algo.FleetEntry) fyne.CanvasObject {
+	if len(entries) == 0 {
+		settings := widget.NewButtonWithIcon("Settings", theme.SettingsIcon(), func() {
+			RenderView(&SettingsView{})
+		})
+		return container.NewCenter(container.NewVBox(
+			widget.NewLabel("Please add the addresses of your fleet in the settings."),
+			container.NewCenter(settings),
+		))
+	}
+
+	sortColumn := a.FleetSort()
+	if sortColumn == "" {
+		sortColumn = algo.FleetSortProblems
+	}
+	algo.SortFleet(entries, sortColumn)
+
+	foreground := theme.Color(theme.ColorNameForeground)
+
+	// createHeaderButton creates a new header button that sorts the fleet by the column.
+	createHeaderButton := func(text, column string, width float32) fyne.CanvasObject {
+		button := widget.NewButton(text, func() {
+			a.SetFleetSort(column)
+			RenderView(&FleetView{})
+		})
+		button.Importance = widget.LowImportance
+		button.Alignment = widget.ButtonAlignLeading
+		if column == sortColumn {
+			button.SetIcon(theme.MenuDropDownIcon())
+			button.IconPlacement = widget.ButtonIconTrailingText
+			button.Importance = widget.MediumImportance
+		}
+		return container.New(layout.NewGridWrapLayout(fyne.NewSize(width, button.MinSize().Height)), button)
+	}
+
+	// createHeaderLabel creates a new header label for a column that cannot be sorted.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, foreground)
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, c)
+		label.SetMinWidth(width)
+		return label
+	}
+
+	// createIndicator creates a new colored indicator for a health level.
+	createIndicator := func(level string) fyne.CanvasObject {
+		dot := canvas.NewCircle(healthColor(level))
+		return container.NewCenter(container.NewGridWrap(fyne.NewSize(12, 12), dot))
+	}
+
+	// createAddress creates a new shortened address linked to allo.info.
+	createAddress := func(address string) fyne.CanvasObject {
+		link := iw.NewHyperlink(format.AddressShort(address), &url.URL{
+			Scheme: "https",
+			Host:   "allo.info",
+			Path:   fmt.Sprintf("/account/%s", address),
+		})
+		link.TextSize = theme.TextSize()
+		link.Color = theme.Color(theme.ColorNameHyperlink)
+		return container.New(layout.NewGridWrapLayout(fyne.NewSize(130, link.MinSize().Height+10)),
+			container.NewHBox(container.NewCenter(link)),
+		)
+	}
+
+	// createSince creates a new cell with the time since an event in rounds.
+	createSince := func(e *algo.FleetEntry, rounds int64, since func() string) *iw.ColorLabel {
+		switch {
+		case e.Account == nil:
+			return createCellLabel("-", Grey, 110)
+		case e.RoundUnknown():
+			return createCellLabel("Unknown", Amber, 110)
+		case rounds < 0:
+			return createCellLabel("Never", Grey, 110)
+		default:
+			return createCellLabel(since(), foreground, 110)
+		}
+	}
+
+	// createFleetItem creates a new fleet item highlighted by its worst problem.
+	createFleetItem := func(e *algo.FleetEntry) fyne.CanvasObject {
+		var statusColor, eligibleColor, expiryColor color.Color = Grey, Grey, Grey
+		status, eligible, expiry := "-", "-", "-"
+		if e.Account != nil {
+			status, statusColor = e.Account.Status, DarkGreen
+			if !e.Account.Online() {
+				statusColor = DarkRed
+			}
+			eligible, eligibleColor = "Yes", DarkGreen
+			if !e.Account.IncentiveEligible {
+				eligible, eligibleColor = "No", DarkRed
+			}
+			if e.KeyExpiry != nil {
+				expiry, expiryColor = format.Duration(e.KeyExpiry.Remaining()), foreground
+				if e.KeyExpiry.Expired() {
+					expiry, expiryColor = "Expired", DarkRed
+				}
+			}
+		}
+
+		var problems []string
+		for _, problem := range e.Problems {
+			problems = append(problems, fmt.Sprintf("%s: %s", problem.Label, problem.Value))
+		}
+		problemsText := canvas.NewText(strings.Join(problems, ", "), healthColor(e.Level()))
+		problemsText.TextSize = 11
+		if len(problems) == 0 {
+			problemsText.Text = "No problems"
+			problemsText.Color = Grey
+		}
+
+		row := container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), container.NewVBox(
+			container.NewHBox(
+				createIndicator(e.Level()),
+				createAddress(e.Address),
+				createCellLabel(status, statusColor, 80),
+				createCellLabel(eligible, eligibleColor, 70),
+				createSince(e, e.RoundsSinceProposal(), func() string { return format.Duration(e.SinceProposal()) }),
+				createSince(e, e.RoundsSinceHeartbeat(), func() string { return format.Duration(e.SinceHeartbeat()) }),
+				createCellLabel(expiry, expiryColor, 110),
+				layout.NewSpacer(),
+				createCellLabel(fmt.Sprintf("%s (%d)", format.Float(e.Rewards7), e.Wins7), foreground, 120),
+				createCellLabel(fmt.Sprintf("%s (%d)", format.Float(e.Rewards30), e.Wins30), foreground, 120),
+			),
+			container.New(layout.NewCustomPaddedLayout(0, 5, 25, 0), problemsText),
+		))
+
+		// Highlight problem accounts
+		if e.Level() == algo.HealthOK {
+			return row
+		}
+		c := color.NRGBA{R: DarkRed.R, G: DarkRed.G, B: DarkRed.B, A: 32}
+		if e.Level() == algo.HealthWarning {
+			c = color.NRGBA{R: Amber.R, G: Amber.G, B: Amber.B, A: 32}
+		}
+		return container.NewStack(canvas.NewRectangle(c), row)
+	}
+
+	// Count the problem accounts
+	var problemCount int
+	for _, e := range entries {
+		if e.Level() != algo.HealthOK {
+			problemCount++
+		}
+	}
+	title := canvas.NewText(fmt.Sprintf("Fleet: %d Accounts", len(entries)), foreground)
+	title.TextStyle.Bold = true
+	summary := canvas.NewText("All accounts healthy", DarkGreen)
+	if problemCount > 0 {
+		summary = canvas.NewText(fmt.Sprintf("%d with problems", problemCount), DarkRed)
+	}
+	summary.TextSize = 12
+
+	header := container.NewHBox(
+		createHeaderButton("!", algo.FleetSortProblems, 40),
+		createHeaderButton("Address", algo.FleetSortAddress, 130),
+		createHeaderLabel("Status", 80),
+		createHeaderLabel("Eligible", 70),
+		createHeaderButton("Proposal", algo.FleetSortProposal, 110),
+		createHeaderButton("Heartbeat", algo.FleetSortHeartbeat, 110),
+		createHeaderButton("Key Expiry", algo.FleetSortExpiry, 110),
+		layout.NewSpacer(),
+		createHeaderButton("7 Days", algo.FleetSortRewards7, 120),
+		createHeaderButton("30 Days", algo.FleetSortRewards30, 120),
+	)
+
+	content := container.NewVBox()
+	for _, e := range entries {
+		content.Add(createFleetItem(e))
+		content.Add(widget.NewSeparator())
+	}
+
+	l := newAppLayout()
+	l.topBar = container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), container.NewVBox(
+		container.NewHBox(title, container.NewCenter(summary)),
+		header,
+	))
+	l.mainContent = container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), content))
+
+	return l.render()
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// ForecastList returns a list of projected rewards.
+func ForecastList(f *algo.Forecast) fyne.CanvasObject {
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		return label
+	}
+
+	if f == nil {
+		return container.NewCenter(widget.NewLabel("Forecast is unavailable."))
+	}
+
+	header := container.NewHBox(
+		createHeaderLabel("Days", 60),
+		createHeaderLabel("Wins", 150),
+		createHeaderLabel("Rewards", 110),
+		createHeaderLabel("Low", 110),
+		createHeaderLabel("High", 110),
+	)
+
+	content := container.NewVBox()
+	for _, p := range f.Projections {
+		content.Add(container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), container.NewHBox(
+			createCellLabel(fmt.Sprintf("%d", p.Days), 60),
+			createCellLabel(fmt.Sprintf("%s (%s - %s)", format.FloatShort(p.ExpectedWins), format.FloatShort(p.LowWins), format.FloatShort(p.HighWins)), 150),
+			AlgoIcon(10),
+			createCellLabel(format.FloatShort(p.Rewards), 100),
+			createCellLabel(format.FloatShort(p.LowRewards), 110),
+			createCellLabel(format.FloatShort(p.HighRewards), 110),
+		)))
+		content.Add(widget.NewSeparator())
+	}
+
+	// Add assumptions
+	assumptions := container.NewVBox()
+	title := canvas.NewText("Assumptions", theme.Color(theme.ColorNameForeground))
+	title.TextStyle.Bold = true
+	title.TextSize = 12
+	assumptions.Add(title)
+	for _, assumption := range f.Assumptions {
+		text := canvas.NewText("• "+assumption, Grey)
+		text.TextSize = 12
+		assumptions.Add(text)
+	}
+
+	return container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewVBox(
+		container.New(layout.NewCustomPaddedLayout(0, 0, 5, 0), header),
+		content,
+		assumptions,
+	)))
+}
+
+// ForecastExportDialog opens a dialog to export the forecast.
+func ForecastExportDialog(a *app.App, w fyne.Window) {
+	d := dialog.NewFileSave(
+		func(writer fyne.URIWriteCloser, err error) {
+			if err != nil {
+				return
+			}
+			if writer == nil {
+				return
+			}
+			defer writer.Close()
+			algo.ExportForecast(a.Address(), writer)
+		},
+		w,
+	)
+	d.SetFileName("forecast.csv")
+	d.SetFilter(storage.NewExtensionFileFilter([]string{".csv"}))
+	d.SetView(dialog.ListView)
+	d.Resize(fyne.NewSize(MainWindowWidth-20, MainWindowHeight-20))
+	d.Show()
+}
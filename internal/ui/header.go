@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"sync"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
@@ -10,7 +12,42 @@ import (
 	"github.com/calmdev/algorand-rewards/internal/format"
 )
 
+// headerAlerts holds the suspension risk and key expiry of the account last
+// checked by WatchAccountAlerts, so rendering the header fetches nothing.
+var headerAlerts struct {
+	sync.Mutex
+	address   string
+	absent    *algo.Absenteeism
+	keyExpiry *algo.KeyExpiry
+}
+
+// setHeaderAlerts stores the warnings of the account for the header and redraws the header with them.
+func setHeaderAlerts(account *algo.Account, absent *algo.Absenteeism, keyExpiry *algo.KeyExpiry) {
+	headerAlerts.Lock()
+	headerAlerts.address = account.Address
+	headerAlerts.absent = absent
+	headerAlerts.keyExpiry = keyExpiry
+	headerAlerts.Unlock()
+
+	if Layout != nil {
+		Layout.updateTopBar(Header(account))
+	}
+}
+
+// cachedHeaderAlerts returns the last checked warnings of the account, or nil if it has not been checked.
+func cachedHeaderAlerts(account *algo.Account) (*algo.Absenteeism, *algo.KeyExpiry) {
+	headerAlerts.Lock()
+	defer headerAlerts.Unlock()
+
+	if headerAlerts.address != account.Address {
+		return nil, nil
+	}
+	return headerAlerts.absent, headerAlerts.keyExpiry
+}
+
 // Header returns the header of the application.
+//
+// The suspension risk, key expiry and stake bounds come from the last account alert check.
 func Header(account *algo.Account) fyne.CanvasObject {
 	header := []fyne.CanvasObject{
 		AlgoWordmark(70),
@@ -18,6 +55,15 @@ func Header(account *algo.Account) fyne.CanvasObject {
 	}
 
 	if account != nil {
+		absent, keyExpiry := cachedHeaderAlerts(account)
+		if warning := AbsenteeismWarning(absent); warning != nil {
+			header = append(header, warning, layout.NewSpacer())
+		} else if warning := StakeWarning(account); warning != nil {
+			header = append(header, warning, layout.NewSpacer())
+		} else if warning := KeyExpiryWarning(keyExpiry); warning != nil {
+			header = append(header, warning, layout.NewSpacer())
+		}
+
 		balanceTotal := canvas.NewText(format.Float(account.AlgoBalance()), theme.Color(theme.ColorNameForeground))
 		balanceTotal.TextStyle.Bold = true
 
@@ -25,7 +71,7 @@ func Header(account *algo.Account) fyne.CanvasObject {
 			header,
 			AlgoIcon(10),
 			balanceTotal,
-			AccountStatusIcon(account),
+			AccountStatusIcon(account, absent),
 			canvas.NewText(format.AddressShort(account.Address), theme.Color(theme.ColorNameForeground)),
 		)
 	}
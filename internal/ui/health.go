@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// healthColor returns the color of a health level.
+func healthColor(level string) color.Color {
+	switch level {
+	case algo.HealthOK:
+		return DarkGreen
+	case algo.HealthWarning:
+		return Amber
+	default:
+		return DarkRed
+	}
+}
+
+// HealthList returns the operational state of the account with an indicator for each item.
+func HealthList(h *algo.Health) fyne.CanvasObject {
+	// createIndicator creates a new colored indicator for a health level.
+	createIndicator := func(level string) fyne.CanvasObject {
+		dot := canvas.NewCircle(healthColor(level))
+		return container.NewGridWrap(fyne.NewSize(12, 12), dot)
+	}
+
+	// createLabel creates a new bold label for a health item.
+	createLabel := func(text string) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(170)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createDetail creates a new small detail text for a health item.
+	createDetail := func(text string) *canvas.Text {
+		detail := canvas.NewText(text, Grey)
+		detail.TextSize = 11
+		return detail
+	}
+
+	if h == nil {
+		return container.NewCenter(widget.NewLabel("Health is unavailable."))
+	}
+
+	// Overall state
+	overall := canvas.NewText("All systems healthy", healthColor(h.Level()))
+	switch h.Level() {
+	case algo.HealthWarning:
+		overall.Text = "Some items need attention"
+	case algo.HealthError:
+		overall.Text = "Some items need action"
+	}
+	overall.TextStyle.Bold = true
+	overall.TextSize = 14
+
+	items := container.NewVBox(container.NewHBox(createIndicator(h.Level()), overall), widget.NewSeparator())
+	for _, item := range h.Items {
+		row := container.NewHBox(
+			container.NewCenter(createIndicator(item.Level)),
+			createLabel(item.Label),
+			iw.NewColorLabel(item.Value, healthColor(item.Level)),
+			layout.NewSpacer(),
+			createDetail(item.Detail),
+		)
+		items.Add(row)
+		items.Add(widget.NewSeparator())
+	}
+
+	return container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), items))
+}
@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// heartbeatChartDays is the number of days shown in the heartbeat chart.
+const heartbeatChartDays = 30
+
+// HeartbeatList returns the heartbeat history and the time since the last heartbeat.
+func HeartbeatList(h *algo.HeartbeatHistory) fyne.CanvasObject {
+	// createText creates a new text for the heartbeat summary.
+	createText := func(label, value string, c color.Color) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, c)
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	// createTitle creates a new title for a chart.
+	createTitle := func(title string) *canvas.Text {
+		text := canvas.NewText(title, Grey)
+		text.TextStyle.Bold = true
+		text.TextSize = 11
+		return text
+	}
+
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, c)
+		label.SetMinWidth(width)
+		return label
+	}
+
+	if h == nil || h.Last() == nil {
+		return container.NewCenter(widget.NewLabel("No heartbeats found for this account."))
+	}
+
+	// Summary
+	sinceColor := theme.Color(theme.ColorNameForeground)
+	if h.Overdue() {
+		sinceColor = DarkRed
+	}
+	challengeColor := theme.Color(theme.ColorNameForeground)
+	if h.MissedChallenge() {
+		challengeColor = DarkRed
+	}
+	usual := "-"
+	if interval := h.UsualInterval(); interval > 0 {
+		usual = format.Duration(interval)
+	}
+	alert := "-"
+	if alertAfter := h.AlertAfter(); alertAfter > 0 {
+		alert = format.Duration(alertAfter)
+	}
+	summary := container.NewHBox(
+		createText("Heartbeats: ", format.Int(int64(len(h.Heartbeats))), theme.Color(theme.ColorNameForeground)),
+		layout.NewSpacer(),
+		createText("Challenges: ", format.Int(int64(h.Challenges())), challengeColor),
+		layout.NewSpacer(),
+		createText("Since Last: ", format.Duration(h.SinceLast()), sinceColor),
+		layout.NewSpacer(),
+		createText("Usual Interval: ", usual, theme.Color(theme.ColorNameForeground)),
+		layout.NewSpacer(),
+		createText("Alert After: ", alert, Grey),
+	)
+
+	// Heartbeats by day
+	var labels []string
+	for i := range heartbeatChartDays {
+		labels = append(labels, h.Now.AddDate(0, 0, i-heartbeatChartDays+1).Format("2"))
+	}
+	chart := iw.NewBarChart(h.ByDay(heartbeatChartDays), labels, theme.Color(theme.ColorNamePrimary), 60)
+	chart.SetLabelColor(Grey)
+
+	// Heartbeat history
+	header := container.NewHBox(
+		createHeaderLabel("Time", 150),
+		createHeaderLabel("Round", 100),
+		createHeaderLabel("Sender", 110),
+		createHeaderLabel("Fee", 80),
+		createHeaderLabel("Challenge", 80),
+	)
+	list := widget.NewList(
+		func() int {
+			return len(h.Heartbeats)
+		},
+		func() fyne.CanvasObject {
+			return container.NewHBox(
+				createCellLabel("", Grey, 150),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 100),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 110),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 80),
+				createCellLabel("", DarkGreen, 80),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			heartbeat := h.Heartbeats[id]
+			cells := obj.(*fyne.Container).Objects
+			cells[0].(*iw.ColorLabel).SetText(heartbeat.Time.Format("2006-01-02 15:04"))
+			cells[1].(*iw.ColorLabel).SetText(fmt.Sprintf("%d", heartbeat.Round))
+			cells[2].(*iw.ColorLabel).SetText(format.AddressShort(heartbeat.Sender))
+			cells[3].(*iw.ColorLabel).SetText(format.Float(float64(heartbeat.Fee) / 1e6))
+			if heartbeat.Challenge {
+				cells[4].(*iw.ColorLabel).SetText("Yes")
+			} else {
+				cells[4].(*iw.ColorLabel).SetText("")
+			}
+		},
+	)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewBorder(
+		container.NewVBox(
+			summary,
+			createTitle(fmt.Sprintf("Heartbeats by Day (last %d days)", heartbeatChartDays)),
+			chart,
+			header,
+		),
+		nil,
+		nil,
+		nil,
+		list,
+	))
+}
+
+// HeartbeatMessage returns the missed heartbeat or challenge warning, or an empty string if none.
+func HeartbeatMessage(h *algo.HeartbeatHistory) string {
+	switch {
+	case h == nil:
+		return ""
+	case h.MissedChallenge():
+		return fmt.Sprintf("No heartbeat for the challenge of round %d", h.Unanswered)
+	case h.Overdue():
+		return fmt.Sprintf("No heartbeat for %s", format.Duration(h.SinceLast()))
+	}
+	return ""
+}
+
+// AlertHeartbeats shows the missed heartbeat warning in the system tray and
+// sends a desktop notification once for each missed heartbeat.
+func AlertHeartbeats(a *app.App, h *algo.HeartbeatHistory) {
+	message := HeartbeatMessage(h)
+	setTrayNotice(a, "heartbeat", message)
+
+	if message == "" {
+		return
+	}
+
+	// Notify once per last heartbeat or missed challenge
+	notified := fmt.Sprintf("challenge %d", h.Unanswered)
+	if !h.MissedChallenge() {
+		notified = fmt.Sprintf("%d", h.Last().Round)
+	}
+	if a.HeartbeatAlertNotified() == notified {
+		return
+	}
+	a.SetHeartbeatAlertNotified(notified)
+	a.SendNotification(fyne.NewNotification(app.AppName, message))
+}
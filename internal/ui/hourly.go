@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// HourlyList returns the distribution of wins and payouts by hour and the weekday by hour matrix.
+func HourlyList(d *algo.HourlyDistribution) fyne.CanvasObject {
+	// createText creates a new text for the hourly summary.
+	createText := func(label, value string) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, theme.Color(theme.ColorNameForeground))
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	// createTitle creates a new title for a chart.
+	createTitle := func(title string) *canvas.Text {
+		text := canvas.NewText(title, Grey)
+		text.TextStyle.Bold = true
+		text.TextSize = 11
+		return text
+	}
+
+	// createLabel creates a new small label for the matrix.
+	createLabel := func(label string) *canvas.Text {
+		text := canvas.NewText(label, Grey)
+		text.TextSize = 8
+		text.Alignment = fyne.TextAlignCenter
+		return text
+	}
+
+	// heatColor returns the primary color faded by the share of the busiest cell.
+	heatColor := func(share float64) color.Color {
+		r, g, b, _ := theme.Color(theme.ColorNamePrimary).RGBA()
+		alpha := uint8(20 + 235*share)
+		return color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: alpha}
+	}
+
+	summary := container.NewHBox(
+		createText("Wins: ", format.Int(d.Total)),
+		layout.NewSpacer(),
+		createText("Even Spread: ", format.FloatShort(d.ExpectedPerHour())+" / hour"),
+		layout.NewSpacer(),
+		createText("Busiest: ", fmt.Sprintf("%02d:00 (%d)", d.BusiestHour(), d.Wins[d.BusiestHour()])),
+		layout.NewSpacer(),
+		createText("Quietest: ", fmt.Sprintf("%02d:00 (%d)", d.QuietestHour(), d.Wins[d.QuietestHour()])),
+	)
+
+	// Wins and payouts by hour
+	var wins, payouts []float64
+	var labels []string
+	for hour := range 24 {
+		wins = append(wins, float64(d.Wins[hour]))
+		payouts = append(payouts, d.Payouts[hour])
+		labels = append(labels, fmt.Sprintf("%d", hour))
+	}
+	winsChart := iw.NewBarChart(wins, labels, theme.Color(theme.ColorNamePrimary), 60)
+	winsChart.SetLabelColor(Grey)
+	payoutsChart := iw.NewBarChart(payouts, labels, DarkGreen, 60)
+	payoutsChart.SetLabelColor(Grey)
+
+	// Weekday by hour matrix
+	matrix := container.NewGridWithColumns(25, createLabel(""))
+	for hour := range 24 {
+		matrix.Add(createLabel(fmt.Sprintf("%d", hour)))
+	}
+	most := d.MaxCell()
+	for row, day := range d.Weekdays {
+		matrix.Add(createLabel(day.String()[:3]))
+		for hour := range 24 {
+			var share float64
+			if most > 0 {
+				share = float64(d.Matrix[row][hour]) / float64(most)
+			}
+			cell := canvas.NewRectangle(heatColor(share))
+			cell.SetMinSize(fyne.NewSize(10, 12))
+			cell.CornerRadius = 2
+			matrix.Add(cell)
+		}
+	}
+
+	return container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewVBox(
+		summary,
+		createTitle("Wins by Hour"),
+		winsChart,
+		createTitle("Payouts by Hour"),
+		payoutsChart,
+		createTitle("Wins by Weekday and Hour"),
+		matrix,
+	)))
+}
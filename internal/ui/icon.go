@@ -219,14 +219,17 @@ func TransactionTypeIcon(t string, size float32) fyne.CanvasObject {
 }
 
 // AccountStatusIcon returns the account status icon.
-func AccountStatusIcon(account *algo.Account) fyne.Widget {
+func AccountStatusIcon(account *algo.Account, absent *algo.Absenteeism) fyne.Widget {
 	var activity *iw.Activity
 
-	// Check if account is eligible for rewards.
-	if account.IncentiveEligible {
-		activity = iw.NewActivity(DarkGreen, 20)
-	} else {
+	// Check if account is eligible for rewards and not at risk of suspension.
+	switch {
+	case !account.IncentiveEligible || (absent != nil && absent.Absent()):
 		activity = iw.NewActivity(DarkRed, 20)
+	case absent != nil && absent.AtRisk():
+		activity = iw.NewActivity(Amber, 20)
+	default:
+		activity = iw.NewActivity(DarkGreen, 20)
 	}
 
 	activity.Start()
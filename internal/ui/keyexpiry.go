@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+)
+
+// KeyExpiryMessage returns the participation key expiry warning, or an empty string if none.
+func KeyExpiryMessage(expiry *algo.KeyExpiry) string {
+	if expiry == nil {
+		return ""
+	}
+	if expiry.Expired() {
+		return "Participation key expired"
+	}
+	if expiry.Warning(algo.KeyExpiryWarnings()) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Participation key expires in %s (%s)", format.Duration(expiry.Remaining()), expiry.Expires.Format("Jan 2"))
+}
+
+// KeyExpiryWarning returns the participation key expiry warning for the header, or nil if none.
+func KeyExpiryWarning(expiry *algo.KeyExpiry) fyne.CanvasObject {
+	message := KeyExpiryMessage(expiry)
+	if message == "" {
+		return nil
+	}
+
+	icon := widget.NewIcon(theme.NewErrorThemedResource(theme.WarningIcon()))
+	text := canvas.NewText(message, DarkRed)
+	text.TextStyle.Bold = true
+	text.TextSize = 12
+
+	return container.NewHBox(icon, container.NewCenter(text))
+}
+
+// AlertKeyExpiry shows the participation key expiry warning in the system tray and
+// sends a desktop notification once for each threshold crossed.
+func AlertKeyExpiry(a *app.App, expiry *algo.KeyExpiry) {
+	message := KeyExpiryMessage(expiry)
+	setTrayNotice(a, "key", message)
+
+	if message == "" {
+		return
+	}
+
+	// Notify once per key and threshold
+	notified := fmt.Sprintf("%d:%d", expiry.LastValid, expiry.Warning(algo.KeyExpiryWarnings()))
+	if expiry.Expired() {
+		notified = fmt.Sprintf("%d:expired", expiry.LastValid)
+	}
+	if a.KeyExpiryNotified() == notified {
+		return
+	}
+	a.SetKeyExpiryNotified(notified)
+	a.SendNotification(fyne.NewNotification(app.AppName, message))
+}
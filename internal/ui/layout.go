@@ -10,9 +10,10 @@ import (
 // Layout is the application layout.
 var Layout *appLayout
 
-// RenderLayout renders the application layout.
-func RenderLayout() fyne.CanvasObject {
+// RenderLayout renders the application layout for the given window.
+func RenderLayout(w fyne.Window) fyne.CanvasObject {
 	Layout = newAppLayout()
+	Layout.window = w
 	Layout.topBar = Header(nil) // No account yet
 	Layout.leftBar = LeftSidebar()
 
@@ -31,6 +32,7 @@ type appLayout struct {
 
 	container   *fyne.Container
 	currentView View
+	window      fyne.Window
 }
 
 // newAppLayout returns a new AppLayout.
@@ -61,6 +63,11 @@ func (l *appLayout) loading() {
 	l.updateMainContent(loading)
 }
 
+// unavailable renders a message in the main content when its data could not be fetched.
+func (l *appLayout) unavailable(message string) {
+	l.updateMainContent(container.NewCenter(widget.NewLabel(message)))
+}
+
 // updateMainContent updates the main content.
 func (l *appLayout) updateMainContent(content fyne.CanvasObject) {
 	l.mainContent = content
@@ -88,10 +95,10 @@ func (l *appLayout) markActiveButton(index int) {
 		}
 	}
 
-	// Disable all buttons except settings if there is no address configured.
+	// Disable all buttons except settings (the last button) if there is no address configured.
 	if app.CurrentApp().Address() == "" {
 		for i, obj := range leftBar {
-			if btn, ok := obj.(*widget.Button); ok && i != 3 {
+			if btn, ok := obj.(*widget.Button); ok && i != len(leftBar)-1 {
 				btn.Disable()
 			}
 		}
@@ -12,6 +12,9 @@ func LeftSidebar() fyne.CanvasObject {
 	var rewards *widget.Button
 	var settings *widget.Button
 	var transactions *widget.Button
+	var forecast *widget.Button
+	var account *widget.Button
+	var health *widget.Button
 
 	var iconContainer *fyne.Container
 
@@ -42,9 +45,39 @@ func LeftSidebar() fyne.CanvasObject {
 		},
 	}
 
+	forecast = &widget.Button{
+		Importance: widget.LowImportance,
+		Icon:       theme.MediaFastForwardIcon(),
+		OnTapped: func() {
+			RenderView(&ForecastView{})
+			iconContainer.Refresh()
+		},
+	}
+
+	account = &widget.Button{
+		Importance: widget.LowImportance,
+		Icon:       theme.AccountIcon(),
+		OnTapped: func() {
+			RenderView(&AccountView{})
+			iconContainer.Refresh()
+		},
+	}
+
+	health = &widget.Button{
+		Importance: widget.LowImportance,
+		Icon:       theme.ComputerIcon(),
+		OnTapped: func() {
+			RenderView(&HealthView{})
+			iconContainer.Refresh()
+		},
+	}
+
 	iconContainer = container.NewVBox(
 		rewards,
 		transactions,
+		forecast,
+		account,
+		health,
 		settings,
 	)
 
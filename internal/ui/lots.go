@@ -0,0 +1,241 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// lotMethodLabels are the labels of the lot methods.
+var lotMethodLabels = map[string]string{
+	"fifo": "FIFO (First In, First Out)",
+	"lifo": "LIFO (Last In, First Out)",
+	"hifo": "HIFO (Highest In, First Out)",
+}
+
+// LotList returns the open reward lots and the disposals matched against them.
+func LotList(r *algo.LotReport) fyne.CanvasObject {
+	// createText creates a new text for the lot summary.
+	createText := func(label, value string, c color.Color) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, c)
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, c)
+		label.SetMinWidth(width)
+		return label
+	}
+
+	// gainColor returns the color of a gain or loss.
+	gainColor := func(gain float64) color.Color {
+		if gain > 0 {
+			return DarkGreen
+		}
+		if gain < 0 {
+			return DarkRed
+		}
+		return theme.Color(theme.ColorNameForeground)
+	}
+
+	// Method selector
+	var labels []string
+	for _, method := range algo.LotMethods {
+		labels = append(labels, lotMethodLabels[method])
+	}
+	method := widget.NewSelect(labels, nil)
+	method.SetSelected(lotMethodLabels[r.Method])
+	method.OnChanged = func(string) {
+		app.CurrentApp().SetLotMethod(algo.LotMethods[method.SelectedIndex()])
+		RenderView(&LotsView{})
+	}
+
+	// Export button
+	export := widget.NewButtonWithIcon("Export", theme.DocumentSaveIcon(), func() {
+		LotsExportDialog(r, Layout.window)
+	})
+
+	// Summary of gains
+	currency := strings.ToUpper(r.Currency)
+	summary := container.NewHBox(
+		createText("Realized: ", format.Fiat(r.Realized)+" "+currency, gainColor(r.Realized)),
+		layout.NewSpacer(),
+		createText("Unrealized: ", format.Fiat(r.Unrealized)+" "+currency, gainColor(r.Unrealized)),
+		layout.NewSpacer(),
+		createText("Open: ", format.FloatShort(r.AlgoRemaining()), theme.Color(theme.ColorNameForeground)),
+		layout.NewSpacer(),
+		createText("Unmatched: ", format.FloatShort(r.AlgoUnmatched()), Grey),
+	)
+
+	// Warn about lots and disposals left out of the gains
+	var missing []string
+	if r.Missing > 0 {
+		missing = append(missing, fmt.Sprintf("%s lots and disposals without a price are left out of the gains", format.Int(int64(r.Missing))))
+	}
+	if r.NoPrice {
+		missing = append(missing, "No current price, unrealized gains are not known")
+	}
+	warning := container.NewVBox()
+	for _, message := range missing {
+		warning.Add(iw.NewColorLabel(message, Amber))
+	}
+
+	// Open lots
+	lotsHeader := container.NewHBox(
+		createHeaderLabel("Acquired", 100),
+		createHeaderLabel("Round", 100),
+		createHeaderLabel("Remaining", 110),
+		createHeaderLabel("Price", 80),
+		createHeaderLabel("Cost Basis", 90),
+		createHeaderLabel("Gain", 90),
+	)
+	lots := widget.NewList(
+		func() int {
+			return len(r.Lots)
+		},
+		func() fyne.CanvasObject {
+			return container.NewHBox(
+				createCellLabel("", Grey, 100),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 100),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 110),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 80),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			lot := r.Lots[id]
+			gain := lot.AlgoRemaining()*r.Price - lot.CostBasis()
+			cells := obj.(*fyne.Container).Objects
+			cells[0].(*iw.ColorLabel).SetText(lot.Acquired.Format("2006-01-02"))
+			cells[1].(*iw.ColorLabel).SetText(fmt.Sprintf("%d", lot.Round))
+			cells[2].(*iw.ColorLabel).SetText(format.Float(lot.AlgoRemaining()))
+			cells[3].(*iw.ColorLabel).SetText(fmt.Sprintf("%.4f", lot.Price))
+			cells[4].(*iw.ColorLabel).SetText(format.Fiat(lot.CostBasis()))
+			cells[5].(*iw.ColorLabel).SetText(format.Fiat(gain))
+			cells[5].(*iw.ColorLabel).SetColor(gainColor(gain))
+			if lot.PriceMissing {
+				cells[3].(*iw.ColorLabel).SetText("Missing")
+				cells[4].(*iw.ColorLabel).SetText("-")
+			}
+			if lot.PriceMissing || r.NoPrice {
+				cells[5].(*iw.ColorLabel).SetText("-")
+				cells[5].(*iw.ColorLabel).SetColor(Grey)
+			}
+		},
+	)
+
+	// Disposals
+	disposalsHeader := container.NewHBox(
+		createHeaderLabel("Date", 100),
+		createHeaderLabel("Round", 100),
+		createHeaderLabel("Amount", 110),
+		createHeaderLabel("Proceeds", 90),
+		createHeaderLabel("Cost Basis", 90),
+		createHeaderLabel("Gain", 90),
+		createHeaderLabel("Lots", 50),
+	)
+	disposals := widget.NewList(
+		func() int {
+			return len(r.Disposals)
+		},
+		func() fyne.CanvasObject {
+			return container.NewHBox(
+				createCellLabel("", Grey, 100),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 100),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 110),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 50),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			disposal := r.Disposals[id]
+			cells := obj.(*fyne.Container).Objects
+			cells[0].(*iw.ColorLabel).SetText(disposal.Time.Format("2006-01-02"))
+			cells[1].(*iw.ColorLabel).SetText(fmt.Sprintf("%d", disposal.Round))
+			cells[2].(*iw.ColorLabel).SetText(format.Float(disposal.AlgoAmount()))
+			cells[3].(*iw.ColorLabel).SetText(format.Fiat(disposal.Proceeds))
+			cells[4].(*iw.ColorLabel).SetText(format.Fiat(disposal.CostBasis))
+			cells[5].(*iw.ColorLabel).SetText(format.Fiat(disposal.Gain))
+			cells[5].(*iw.ColorLabel).SetColor(gainColor(disposal.Gain))
+			if disposal.PriceMissing {
+				cells[3].(*iw.ColorLabel).SetText("Missing")
+			}
+			if disposal.GainMissing() {
+				cells[5].(*iw.ColorLabel).SetText("Missing")
+				cells[5].(*iw.ColorLabel).SetColor(Amber)
+			}
+			cells[6].(*iw.ColorLabel).SetText(fmt.Sprintf("%d", len(disposal.Matches)))
+		},
+	)
+
+	tabs := container.NewAppTabs(
+		container.NewTabItem("Open Lots", container.NewBorder(lotsHeader, nil, nil, nil, lots)),
+		container.NewTabItem("Disposals", container.NewBorder(disposalsHeader, nil, nil, nil, disposals)),
+	)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewBorder(
+		container.NewVBox(
+			container.NewBorder(nil, nil, nil, export, method),
+			summary,
+			warning,
+		),
+		nil,
+		nil,
+		nil,
+		tabs,
+	))
+}
+
+// LotsExportDialog opens a dialog to export a lot report.
+func LotsExportDialog(r *algo.LotReport, w fyne.Window) {
+	d := dialog.NewFileSave(
+		func(writer fyne.URIWriteCloser, err error) {
+			if err != nil {
+				return
+			}
+			if writer == nil {
+				return
+			}
+			defer writer.Close()
+			algo.ExportLotReport(r, writer)
+		},
+		w,
+	)
+	d.SetFileName("cost-basis.csv")
+	d.SetFilter(storage.NewExtensionFileFilter([]string{".csv"}))
+	d.SetView(dialog.ListView)
+	d.Resize(fyne.NewSize(MainWindowWidth-20, MainWindowHeight-20))
+	d.Show()
+}
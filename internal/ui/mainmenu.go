@@ -1,8 +1,7 @@
 package ui
 
 import (
-	"fmt"
-	"net/url"
+	"slices"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/dialog"
@@ -43,27 +42,51 @@ func accountMenu(a *app.App, w fyne.Window) *fyne.Menu {
 	telemetry = &fyne.MenuItem{
 		Label: "Telemetry",
 		Action: func() {
-			guid := a.GUID()
-			if guid == "" {
+			if a.GUID() == "" {
 				dialog.ShowInformation("Telemetry", "Please set your GUID in the settings to enable telemetry.", w)
 				return
 			}
-			url := url.URL{
-				Scheme:   "https",
-				Host:     "g.nodely.io",
-				Path:     "/d/telemetry/node-telemetry",
-				RawQuery: fmt.Sprintf("var-GUID=%s&orgId=1&from=now-24h&to=now", guid),
-			}
-			if err := a.OpenURL(&url); err != nil {
-				dialog.ShowError(err, w)
-			}
+			RenderView(&TelemetryView{})
+			w.Show()
 		},
 	}
 
 	sep := fyne.NewMenuItemSeparator()
 
+	details := &fyne.MenuItem{
+		Label: "Details",
+		Action: func() {
+			RenderView(&AccountView{})
+		},
+	}
+
+	heartbeats := &fyne.MenuItem{
+		Label: "Heartbeats",
+		Action: func() {
+			RenderView(&HeartbeatView{})
+		},
+	}
+
+	timeline := &fyne.MenuItem{
+		Label: "Online Timeline",
+		Action: func() {
+			RenderView(&TimelineView{})
+		},
+	}
+
+	fleet := &fyne.MenuItem{
+		Label: "Fleet",
+		Action: func() {
+			RenderView(&FleetView{})
+		},
+	}
+
 	return fyne.NewMenu("Account",
 		refresh,
+		details,
+		heartbeats,
+		timeline,
+		fleet,
 		settings,
 		sep,
 		telemetry,
@@ -79,6 +102,12 @@ func rewardsMenu(a *app.App, w fyne.Window) *fyne.Menu {
 	var rewardsByQuarter *fyne.MenuItem
 	var rewardsByYear *fyne.MenuItem
 	var exportRewards *fyne.MenuItem
+	var compare *fyne.MenuItem
+	var breakdown *fyne.MenuItem
+	var tax *fyne.MenuItem
+	var hourly *fyne.MenuItem
+	var forecast *fyne.MenuItem
+	var exportForecast *fyne.MenuItem
 
 	rewardsViewPref := a.RewardsView()
 	toggleChecked := func(view string) {
@@ -177,6 +206,53 @@ func rewardsMenu(a *app.App, w fyne.Window) *fyne.Menu {
 		},
 	}
 
+	compare = &fyne.MenuItem{
+		Label: "Compare Periods",
+		Action: func() {
+			RenderView(&ComparisonView{})
+			w.Show()
+		},
+	}
+
+	breakdown = &fyne.MenuItem{
+		Label: "Bonus Breakdown",
+		Action: func() {
+			RenderView(&BreakdownView{})
+			w.Show()
+		},
+	}
+
+	hourly = &fyne.MenuItem{
+		Label: "Hourly Distribution",
+		Action: func() {
+			RenderView(&HourlyView{})
+			w.Show()
+		},
+	}
+
+	tax = &fyne.MenuItem{
+		Label: "Tax Report",
+		Action: func() {
+			RenderView(&TaxView{})
+			w.Show()
+		},
+	}
+
+	forecast = &fyne.MenuItem{
+		Label: "Forecast",
+		Action: func() {
+			RenderView(&ForecastView{})
+			w.Show()
+		},
+	}
+
+	exportForecast = &fyne.MenuItem{
+		Label: "Export Forecast",
+		Action: func() {
+			ForecastExportDialog(a, w)
+		},
+	}
+
 	sep := fyne.NewMenuItemSeparator()
 
 	return fyne.NewMenu(
@@ -188,10 +264,210 @@ func rewardsMenu(a *app.App, w fyne.Window) *fyne.Menu {
 		rewardsByQuarter,
 		rewardsByYear,
 		sep,
+		rewardsRangeMenuItem(a, w),
+		rewardsColumnsMenuItem(a, w),
+		rewardsPanelMenuItem(a, w),
+		sep,
+		compare,
+		breakdown,
+		hourly,
+		tax,
+		forecast,
+		sep,
 		exportRewards,
+		exportForecast,
 	)
 }
 
+// rewardsPanelMenuItem returns the menu item to select the rewards panel.
+func rewardsPanelMenuItem(a *app.App, w fyne.Window) *fyne.MenuItem {
+	var luck *fyne.MenuItem
+	var streaks *fyne.MenuItem
+	var statistics *fyne.MenuItem
+	var trend *fyne.MenuItem
+	var milestones *fyne.MenuItem
+	var balance *fyne.MenuItem
+
+	rewardsPanelPref := a.RewardsPanel()
+	toggleChecked := func(panel string) {
+		luck.Checked = false
+		streaks.Checked = false
+		statistics.Checked = false
+		trend.Checked = false
+		milestones.Checked = false
+		balance.Checked = false
+		switch panel {
+		case "luck":
+			luck.Checked = true
+			a.SetRewardsPanel(panel)
+		case "streaks":
+			streaks.Checked = true
+			a.SetRewardsPanel(panel)
+		case "statistics":
+			statistics.Checked = true
+			a.SetRewardsPanel(panel)
+		case "trend":
+			trend.Checked = true
+			a.SetRewardsPanel(panel)
+		case "milestones":
+			milestones.Checked = true
+			a.SetRewardsPanel(panel)
+		case "balance":
+			balance.Checked = true
+			a.SetRewardsPanel(panel)
+		}
+	}
+
+	luck = &fyne.MenuItem{
+		Label:   "Luck",
+		Checked: rewardsPanelPref == "luck" || rewardsPanelPref == "",
+		Action: func() {
+			toggleChecked("luck")
+			RenderView(&RewardsView{})
+			w.Show()
+		},
+	}
+
+	streaks = &fyne.MenuItem{
+		Label:   "Streaks",
+		Checked: rewardsPanelPref == "streaks",
+		Action: func() {
+			toggleChecked("streaks")
+			RenderView(&RewardsView{})
+			w.Show()
+		},
+	}
+
+	statistics = &fyne.MenuItem{
+		Label:   "Statistics",
+		Checked: rewardsPanelPref == "statistics",
+		Action: func() {
+			toggleChecked("statistics")
+			RenderView(&RewardsView{})
+			w.Show()
+		},
+	}
+
+	trend = &fyne.MenuItem{
+		Label:   "Trend",
+		Checked: rewardsPanelPref == "trend",
+		Action: func() {
+			toggleChecked("trend")
+			RenderView(&RewardsView{})
+			w.Show()
+		},
+	}
+
+	milestones = &fyne.MenuItem{
+		Label:   "Milestones",
+		Checked: rewardsPanelPref == "milestones",
+		Action: func() {
+			toggleChecked("milestones")
+			RenderView(&RewardsView{})
+			w.Show()
+		},
+	}
+
+	balance = &fyne.MenuItem{
+		Label:   "Balance",
+		Checked: rewardsPanelPref == "balance",
+		Action: func() {
+			toggleChecked("balance")
+			RenderView(&RewardsView{})
+			w.Show()
+		},
+	}
+
+	return &fyne.MenuItem{
+		Label:     "Panel",
+		ChildMenu: fyne.NewMenu("", luck, streaks, statistics, trend, milestones, balance),
+	}
+}
+
+// rewardsColumnsMenuItem returns the menu item to toggle the optional rewards columns.
+func rewardsColumnsMenuItem(a *app.App, w fyne.Window) *fyne.MenuItem {
+	columns := []struct {
+		key   string
+		label string
+	}{
+		{"ma7", "7 Day Average"},
+		{"ma30", "30 Day Average"},
+		{"ma90", "90 Day Average"},
+		{"balance", "Balance"},
+		{"per1k", "Rewards per 1,000 ALGO"},
+		{"winsPerM", "Wins per 1,000,000 ALGO"},
+	}
+
+	rewardsColumnsPref := a.RewardsColumns()
+
+	items := make([]*fyne.MenuItem, len(columns))
+	for i, c := range columns {
+		items[i] = &fyne.MenuItem{
+			Label:   c.label,
+			Checked: slices.Contains(rewardsColumnsPref, c.key),
+			Action: func() {
+				items[i].Checked = !items[i].Checked
+				var selected []string
+				for j, item := range items {
+					if item.Checked {
+						selected = append(selected, columns[j].key)
+					}
+				}
+				a.SetRewardsColumns(selected)
+				RenderView(&RewardsView{})
+				w.Show()
+			},
+		}
+	}
+
+	return &fyne.MenuItem{
+		Label:     "Columns",
+		ChildMenu: fyne.NewMenu("", items...),
+	}
+}
+
+// rewardsRangeMenuItem returns the menu item to select the rewards date range.
+func rewardsRangeMenuItem(a *app.App, w fyne.Window) *fyne.MenuItem {
+	ranges := []struct {
+		key   string
+		label string
+	}{
+		{"all", "All Time"},
+		{"last7", "Last 7 Days"},
+		{"last30", "Last 30 Days"},
+		{"last90", "Last 90 Days"},
+		{"last365", "Last 365 Days"},
+		{"thisYear", "This Year"},
+	}
+
+	rewardsRangePref := a.RewardsRange()
+	if rewardsRangePref == "" {
+		rewardsRangePref = "all"
+	}
+
+	items := make([]*fyne.MenuItem, len(ranges))
+	for i, r := range ranges {
+		items[i] = &fyne.MenuItem{
+			Label:   r.label,
+			Checked: rewardsRangePref == r.key,
+			Action: func() {
+				for _, item := range items {
+					item.Checked = false
+				}
+				items[i].Checked = true
+				a.SetRewardsRange(r.key)
+				RenderView(&RewardsView{})
+				w.Show()
+			},
+		}
+	}
+
+	return &fyne.MenuItem{
+		Label:     "Range",
+		ChildMenu: fyne.NewMenu("", items...),
+	}
+}
+
 // transactionsMenu returns the transactions menu.
 func transactionsMenu(a *app.App, w fyne.Window) *fyne.Menu {
 	history := &fyne.MenuItem{
@@ -201,6 +477,13 @@ func transactionsMenu(a *app.App, w fyne.Window) *fyne.Menu {
 			w.Show()
 		},
 	}
+	costBasis := &fyne.MenuItem{
+		Label: "Cost Basis",
+		Action: func() {
+			RenderView(&LotsView{})
+			w.Show()
+		},
+	}
 	exportTransactions := &fyne.MenuItem{
 		Label: "Export Transactions",
 		Action: func() {
@@ -213,6 +496,7 @@ func transactionsMenu(a *app.App, w fyne.Window) *fyne.Menu {
 	return fyne.NewMenu(
 		"Transactions",
 		history,
+		costBasis,
 		sep,
 		exportTransactions,
 	)
@@ -3,7 +3,10 @@ package ui
 import (
 	"fmt"
 	"image/color"
+	"math"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"fyne.io/fyne/v2"
@@ -17,11 +20,12 @@ import (
 	"github.com/calmdev/algorand-rewards/internal/algo"
 	"github.com/calmdev/algorand-rewards/internal/app"
 	"github.com/calmdev/algorand-rewards/internal/format"
+	"github.com/calmdev/algorand-rewards/internal/price"
 	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
 )
 
-// RewardsPanel returns a panel of reward stats.
-func RewardsPanel(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
+// RewardsPanel returns a panel of reward stats with the progress of the goals.
+func RewardsPanel(account *algo.Account, r *algo.Rewards, goals []algo.Goal) fyne.CanvasObject {
 	// createText creates a new text or hyperlink for the rewards panel.
 	createText := func(label, value string, bold bool, valueURL *url.URL, icon fyne.CanvasObject) *fyne.Container {
 		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
@@ -58,6 +62,10 @@ func RewardsPanel(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
 		Path:   fmt.Sprintf("/%s", account.Address),
 	}, AlgoIcon(10))
 
+	monthTrend := algo.NewTrend(r.Daily, 30, time.Now())
+	trend := createTrendText("30d Trend: ", monthTrend.Direction(), monthTrend.Change)
+	winsTrend := createTrendText("Wins: ", monthTrend.WinsDirection(), monthTrend.WinsChange)
+
 	spacer := layout.NewSpacer()
 
 	stats := container.NewHBox(
@@ -67,10 +75,344 @@ func RewardsPanel(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
 		spacer,
 		maxRewards,
 		spacer,
+		trend,
+		spacer,
+		winsTrend,
+		spacer,
 		rewards,
 	)
+	if r.Currency != "" {
+		stats.Add(spacer)
+		stats.Add(createText("Value: ", format.Fiat(r.TotalFiat)+" "+strings.ToUpper(r.Currency), true, nil, nil))
+	}
+
+	panel := container.NewVBox(stats)
+	if r.Currency != "" && r.Missing > 0 {
+		panel.Add(iw.NewColorLabel(fmt.Sprintf("%s payouts without a price are left out of the value", format.Int(r.Missing)), Amber))
+	}
+	if err := price.LastError(); err != nil && r.Currency == "" {
+		panel.Add(iw.NewColorLabel("Prices could not be fetched: "+err.Error(), Amber))
+	}
+	for _, goal := range goals {
+		panel.Add(GoalPanel(goal))
+	}
+
+	return container.New(layout.NewCustomPaddedLayout(5, 5, 5, 5), panel)
+}
+
+// GoalPanel returns the progress towards a goal with its estimated completion date.
+func GoalPanel(g algo.Goal) fyne.CanvasObject {
+	text := canvas.NewText(g.Label+": ", theme.Color(theme.ColorNameForeground))
+	text.TextStyle.Bold = true
+	text.TextSize = 12
+
+	progress := widget.NewProgressBar()
+	progress.TextFormatter = func() string {
+		return fmt.Sprintf("%s / %s", format.FloatShort(g.Progress), format.FloatShort(g.Target))
+	}
+	progress.SetValue(g.Ratio())
+
+	var value string
+	c := theme.Color(theme.ColorNameForeground)
+	switch {
+	case g.Complete():
+		value, c = "Reached", DarkGreen
+	case g.Completion.IsZero():
+		value = "No recent rewards"
+	default:
+		value = "ETA " + g.Completion.Format("2006-01-02")
+		if !g.OnTrack() {
+			c = DarkRed
+		}
+	}
+	valueText := canvas.NewText(value, c)
+	valueText.TextSize = 12
+	valueText.TextStyle.Bold = true
+
+	return container.NewBorder(nil, nil, text, valueText, progress)
+}
+
+// MilestonesPanel returns a panel of the milestones reached and the blocks that reached them.
+func MilestonesPanel(milestones []algo.Milestone) fyne.CanvasObject {
+	// createText creates a new text with a block link for the milestones panel.
+	createText := func(m algo.Milestone) *fyne.Container {
+		text := canvas.NewText(m.Label+": ", theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		date := canvas.NewText(m.Time.Format("2006-01-02"), Grey)
+		date.TextSize = 12
+
+		round := iw.NewHyperlink(format.Int(m.Round), &url.URL{
+			Scheme: "https",
+			Host:   "allo.info",
+			Path:   fmt.Sprintf("/block/%d", m.Round),
+		})
+		round.TextSize = 12
+		round.TextStyle.Bold = true
+		round.Color = theme.Color(theme.ColorNameHyperlink)
+
+		return container.NewHBox(text, date, layout.NewSpacer(), round)
+	}
+
+	if len(milestones) == 0 {
+		text := canvas.NewText("No milestones reached yet.", Grey)
+		text.TextSize = 12
+		return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), text)
+	}
+
+	grid := container.NewGridWithColumns(3)
+	for _, milestone := range milestones {
+		grid.Add(createText(milestone))
+	}
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), grid)
+}
+
+// createTrendText creates a new text for the direction and relative change of a trend.
+func createTrendText(label, direction string, change float64) *fyne.Container {
+	text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+	text.TextStyle.Bold = true
+	text.TextSize = 12
+
+	var value string
+	var c color.Color
+	switch direction {
+	case "up":
+		value, c = "Up "+format.Percent(change), DarkGreen
+	case "down":
+		value, c = "Down "+format.Percent(-change), DarkRed
+	default:
+		value, c = "Flat "+format.Percent(change), theme.Color(theme.ColorNameForeground)
+	}
+
+	valueText := canvas.NewText(value, c)
+	valueText.TextSize = 12
+	valueText.TextStyle.Bold = true
+
+	return container.NewHBox(text, layout.NewSpacer(), valueText)
+}
+
+// TrendPanel returns a panel of moving averages and trends.
+func TrendPanel(r *algo.Rewards) fyne.CanvasObject {
+	// createText creates a new text for the trend panel.
+	createText := func(label, value string) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, theme.Color(theme.ColorNameForeground))
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	stats := container.NewHBox()
+	for i, window := range algo.MovingAverageWindows {
+		if i > 0 {
+			stats.Add(layout.NewSpacer())
+		}
+
+		// Average of the last complete window
+		trend := algo.NewTrend(r.Daily, window, time.Now())
+		average := trend.Rewards / float64(window)
+		averageWins := float64(trend.Wins) / float64(window)
+
+		stats.Add(createText(fmt.Sprintf("%dd Avg: ", window), fmt.Sprintf("%s (%s wins)", format.FloatShort(average), format.FloatShort(averageWins))))
+		stats.Add(createTrendText("", trend.Direction(), trend.Change))
+		stats.Add(createTrendText("Wins ", trend.WinsDirection(), trend.WinsChange))
+	}
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), stats)
+}
+
+// BalancePanel returns a panel of the daily balance over the selected range.
+func BalancePanel(r *algo.Rewards) fyne.CanvasObject {
+	// createText creates a new text for the balance panel.
+	createText := func(label, value string) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, theme.Color(theme.ColorNameForeground))
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	if r.Balances == nil || len(r.Daily) == 0 {
+		return container.NewHBox()
+	}
+
+	start := r.Start
+	if start == "" {
+		start = r.Daily[0].Date
+	}
+	days := r.Balances.Daily(start, time.Now().Format("2006-01-02"))
+	if len(days) == 0 {
+		return container.NewHBox()
+	}
+
+	var balances []float64
+	lowest, highest := days[0].AlgoMin(), days[0].AlgoMax()
+	for _, day := range days {
+		balances = append(balances, day.AlgoBalance())
+		lowest = math.Min(lowest, day.AlgoMin())
+		highest = math.Max(highest, day.AlgoMax())
+	}
+
+	spacer := layout.NewSpacer()
+
+	stats := container.NewHBox(
+		createText("Start: ", format.FloatShort(days[0].AlgoBalance())),
+		spacer,
+		createText("End: ", format.FloatShort(days[len(days)-1].AlgoBalance())),
+		spacer,
+		createText("Low: ", format.FloatShort(lowest)),
+		spacer,
+		createText("High: ", format.FloatShort(highest)),
+	)
+
+	chart := iw.NewLineChart([][]float64{balances}, []color.Color{theme.Color(theme.ColorNamePrimary)}, 60)
+	chart.SetLabels([]string{days[0].Date, days[len(days)/2].Date, days[len(days)-1].Date}, Grey)
 
-	return container.New(layout.NewCustomPaddedLayout(5, 5, 5, 5), stats)
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewVBox(stats, chart))
+}
+
+// LuckPanel returns a panel of expected versus actual proposals for a payout.
+func LuckPanel(luck *algo.Luck, row algo.PayoutDate) fyne.CanvasObject {
+	// createText creates a new text for the luck panel.
+	createText := func(label, value string, c color.Color) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, c)
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	bucket := luck.Bucket(row)
+	c := luckColor(luck, row)
+
+	spacer := layout.NewSpacer()
+
+	stats := container.NewHBox(
+		createText(row.Date+": ", format.Int(bucket.Actual)+" wins", theme.Color(theme.ColorNameForeground)),
+		spacer,
+		createText("Expected: ", format.FloatShort(bucket.Expected), theme.Color(theme.ColorNameForeground)),
+		spacer,
+		createText("Luck: ", format.Percent(bucket.Ratio), c),
+		spacer,
+		createText("Probability: ", format.Percent(bucket.Probability), c),
+	)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), stats)
+}
+
+// StreaksPanel returns a panel of win streaks and dry spells.
+func StreaksPanel(s *algo.Streaks) fyne.CanvasObject {
+	// createText creates a new text for the streaks panel.
+	createText := func(label, value string, c color.Color) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, c)
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	dryColor := theme.Color(theme.ColorNameForeground)
+	if s.UnusualDrySpell() {
+		dryColor = DarkRed
+	}
+
+	spacer := layout.NewSpacer()
+
+	stats := container.NewHBox(
+		createText("Win Streak: ", fmt.Sprintf("%d (max %d)", s.CurrentWinStreak, s.LongestWinStreak), theme.Color(theme.ColorNameForeground)),
+		spacer,
+		createText("Dry Spell: ", fmt.Sprintf("%d (max %d)", s.CurrentDrySpell, s.LongestDrySpell), dryColor),
+		spacer,
+		createText("Avg Gap: ", fmt.Sprintf("%s / %s rounds", format.Duration(s.AverageGap), format.Int(int64(s.AverageGapRounds))), theme.Color(theme.ColorNameForeground)),
+		spacer,
+		createText("Chance: ", format.Percent(s.DrySpellChance), dryColor),
+	)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), stats)
+}
+
+// StatisticsPanel returns a panel of payout distribution statistics.
+func StatisticsPanel(s *algo.Statistics) fyne.CanvasObject {
+	// createText creates a new text for the statistics panel.
+	createText := func(label, value string) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, theme.Color(theme.ColorNameForeground))
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	spacer := layout.NewSpacer()
+
+	stats := container.NewHBox(
+		createText("Mean: ", format.FloatShort(s.Mean)),
+		spacer,
+		createText("Median: ", format.FloatShort(s.Median)),
+		spacer,
+		createText("Std Dev: ", format.FloatShort(s.StdDev)),
+		spacer,
+		createText("P10/P90: ", format.FloatShort(s.P10)+" / "+format.FloatShort(s.P90)),
+		spacer,
+		createText("Win Rate: ", format.Percent(s.WinRate)),
+	)
+
+	// Histogram of per-block payouts
+	var counts []float64
+	var labels []string
+	for _, bin := range s.Histogram {
+		counts = append(counts, float64(bin.Count))
+		labels = append(labels, bin.Label())
+	}
+	histogram := iw.NewBarChart(counts, labels, theme.Color(theme.ColorNamePrimary), 60)
+	histogram.SetLabelColor(Grey)
+
+	panel := container.NewVBox(stats)
+	if s.AverageStake > 0 {
+		panel.Add(container.NewHBox(
+			createText("Avg Stake: ", format.FloatShort(s.AverageStake)),
+			spacer,
+			createText("Per 1k ALGO: ", format.FloatShort(s.RewardsPerThousand)),
+			spacer,
+			createText("Wins per 1M ALGO: ", format.FloatShort(s.WinsPerMillion)),
+		))
+	}
+	panel.Add(histogram)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), panel)
+}
+
+// luckColor returns the color for the luck of a payout.
+func luckColor(luck *algo.Luck, row algo.PayoutDate) color.Color {
+	bucket := luck.Bucket(row)
+	if bucket.Unusual() && bucket.Unlucky() {
+		return DarkRed
+	}
+	if bucket.Unusual() {
+		return DarkGreen
+	}
+	return theme.Color(theme.ColorNameForeground)
 }
 
 // RewardsList returns a list of rewards.
@@ -82,6 +424,41 @@ func RewardsList(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
 
 	var selected *iw.TappableRectangle
 
+	streaks := algo.NewStreaks(r, r.Luck, time.Now())
+	statistics := algo.NewStatistics(r)
+	milestones := algo.NewMilestones(r)
+	goals := algo.NewGoals(r, app.CurrentApp().MonthlyGoal(), app.CurrentApp().YearlyGoal(), time.Now())
+
+	// Moving averages for the optional columns
+	columns := app.CurrentApp().RewardsColumns()
+	movingAverages := make(map[int]map[string]algo.MovingAverage)
+	for _, window := range algo.MovingAverageWindows {
+		if slices.Contains(columns, fmt.Sprintf("ma%d", window)) {
+			movingAverages[window] = algo.MovingAverages(r.Daily, window)
+		}
+	}
+
+	// Stake-normalised and balance columns need the balance history
+	stakeColumns := make(map[string]bool)
+	if r.Balances != nil {
+		for _, key := range []string{"balance", "per1k", "winsPerM"} {
+			stakeColumns[key] = slices.Contains(columns, key)
+		}
+	}
+
+	// Balance at the end of each day, or the average balance of longer periods
+	dayView := app.CurrentApp().RewardsView() == "day" || app.CurrentApp().RewardsView() == ""
+	balanceOf := func(row algo.PayoutDate) float64 {
+		if !dayView {
+			return row.AverageStake()
+		}
+		day, err := time.ParseInLocation("2006-01-02", row.Date, time.Local)
+		if err != nil {
+			return 0
+		}
+		return float64(r.Balances.At(day.AddDate(0, 0, 1).Add(-time.Second))) / 1e6
+	}
+
 	// createHeaderLabel creates a new header label.
 	createHeaderLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
 		label := iw.NewColorLabel(text, c)
@@ -97,16 +474,43 @@ func RewardsList(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
 		return label
 	}
 
+	// createBottomBar creates the bottom bar for the selected reward.
+	createBottomBar := func(row algo.PayoutDate) *fyne.Container {
+		bottomBar := container.NewVBox(RewardsPanel(account, r, goals))
+		switch app.CurrentApp().RewardsPanel() {
+		case "milestones":
+			bottomBar.Add(MilestonesPanel(milestones))
+		case "streaks":
+			bottomBar.Add(StreaksPanel(streaks))
+		case "statistics":
+			bottomBar.Add(StatisticsPanel(statistics))
+		case "trend":
+			bottomBar.Add(TrendPanel(r))
+		case "balance":
+			bottomBar.Add(BalancePanel(r))
+		default:
+			if r.Luck != nil {
+				bottomBar.Add(LuckPanel(r.Luck, row))
+			}
+		}
+		return bottomBar
+	}
+
+	// selectReward updates the bottom bar for the selected reward.
+	selectReward := func(row algo.PayoutDate, l *appLayout) {
+		l.bottomBar = createBottomBar(row)
+		l.container.Objects[2].(*fyne.Container).RemoveAll()
+		for _, obj := range l.bottomBar.(*fyne.Container).Objects {
+			l.container.Objects[2].(*fyne.Container).Add(obj)
+		}
+		l.container.Objects[2].(*fyne.Container).Refresh()
+	}
+
 	// createRewardItem creates a new reward item.
 	createRewardItem := func(row algo.PayoutDate, l *appLayout, r *algo.Rewards, selected **iw.TappableRectangle) *fyne.Container {
 		rec := iw.NewTappableRectangle(color.Transparent, func() {
 			fmt.Println("Tapped on reward:", row.Date)
-			l.bottomBar = RewardsPanel(account, r)
-			l.container.Objects[2].(*fyne.Container).RemoveAll()
-			for _, obj := range l.bottomBar.(*fyne.Container).Objects {
-				l.container.Objects[2].(*fyne.Container).Add(obj)
-			}
-			l.container.Objects[2].(*fyne.Container).Refresh()
+			selectReward(row, l)
 		}, selected)
 		rec.SetHoverColor(theme.Color(theme.ColorNameHover))
 		rec.SetSelectedColor(theme.Color(theme.ColorNameSelection))
@@ -116,23 +520,66 @@ func RewardsList(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
 			case "dayOfWeek":
 				if row.Date == time.Now().Weekday().String() {
 					rec.Select()
+					selectReward(row, l)
 				}
 			default:
 				rec.Select()
+				selectReward(row, l)
 			}
 		}
 
+		cells := []fyne.CanvasObject{
+			createCellLabel(row.Date, Grey, 120),
+			createCellLabel(fmt.Sprintf("%d", row.TotalWins), theme.Color(theme.ColorNameForeground), 60),
+		}
+		if r.Luck != nil {
+			bucket := r.Luck.Bucket(row)
+			cells = append(cells,
+				createCellLabel(format.FloatShort(bucket.Expected), theme.Color(theme.ColorNameForeground), 80),
+				createCellLabel(format.Percent(bucket.Ratio), luckColor(r.Luck, row), 70),
+			)
+		}
+		cells = append(cells,
+			createCellLabel(format.Float(row.AlgoFeesCollected()), theme.Color(theme.ColorNameForeground), 110),
+			createCellLabel(format.Float(row.AlgoBonus()), theme.Color(theme.ColorNameForeground), 110),
+			AlgoIcon(10),
+			createCellLabel(format.Float(row.AlgoPayout()), theme.Color(theme.ColorNameForeground), 110),
+		)
+		if r.Currency != "" {
+			fiat := createCellLabel(format.Fiat(row.Fiat), theme.Color(theme.ColorNameForeground), 90)
+			if row.MissingPrices > 0 {
+				fiat = createCellLabel("Missing", Amber, 90)
+			}
+			cells = append(cells, fiat)
+		}
+		if stakeColumns["balance"] {
+			cells = append(cells, createCellLabel(format.FloatShort(balanceOf(row)), theme.Color(theme.ColorNameForeground), 110))
+		}
+		if stakeColumns["per1k"] {
+			cells = append(cells, createCellLabel(format.FloatShort(row.RewardsPerThousand()), theme.Color(theme.ColorNameForeground), 110))
+		}
+		if stakeColumns["winsPerM"] {
+			cells = append(cells, createCellLabel(format.FloatShort(row.WinsPerMillion()), theme.Color(theme.ColorNameForeground), 110))
+		}
+		for _, window := range algo.MovingAverageWindows {
+			averages, ok := movingAverages[window]
+			if !ok {
+				continue
+			}
+			text := "-" // Moving averages are only available by day
+			if ma, ok := averages[row.Date]; ok {
+				text = fmt.Sprintf("%s (%s)", format.FloatShort(ma.Rewards), format.FloatShort(ma.Wins))
+			}
+			cells = append(cells, createCellLabel(text, theme.Color(theme.ColorNameForeground), 130))
+		}
+		cells = append(cells, iw.NewTappableIcon(theme.ZoomInIcon(), func() {
+			RenderView(&BlocksView{Period: row.Date})
+		}))
+
 		item := container.NewStack(
 			rec,
 			container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5),
-				container.NewHBox(
-					createCellLabel(row.Date, Grey, 120),
-					createCellLabel(fmt.Sprintf("%d", row.TotalWins), theme.Color(theme.ColorNameForeground), 80),
-					createCellLabel(format.Float(row.AlgoFeesCollected()), theme.Color(theme.ColorNameForeground), 140),
-					createCellLabel(format.Float(row.AlgoBonus()), theme.Color(theme.ColorNameForeground), 140),
-					AlgoIcon(10),
-					createCellLabel(format.Float(row.AlgoPayout()), theme.Color(theme.ColorNameForeground), 140),
-				),
+				container.NewHBox(cells...),
 			),
 		)
 		return item
@@ -152,16 +599,48 @@ func RewardsList(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
 	// Add sticky header
 	header := container.NewHBox(
 		createHeaderLabel("Date", theme.Color(theme.ColorNameForeground), 120),
-		createHeaderLabel("Wins", theme.Color(theme.ColorNameForeground), 80),
-		createHeaderLabel("Fees Collected", theme.Color(theme.ColorNameForeground), 140),
-		createHeaderLabel("Bonus", theme.Color(theme.ColorNameForeground), 140),
-		createHeaderLabel("Rewards", theme.Color(theme.ColorNameForeground), 140),
+		createHeaderLabel("Wins", theme.Color(theme.ColorNameForeground), 60),
 	)
+	if r.Luck != nil {
+		header.Add(createHeaderLabel("Expected", theme.Color(theme.ColorNameForeground), 80))
+		header.Add(createHeaderLabel("Luck", theme.Color(theme.ColorNameForeground), 70))
+	}
+	header.Add(createHeaderLabel("Fees Collected", theme.Color(theme.ColorNameForeground), 110))
+	header.Add(createHeaderLabel("Bonus", theme.Color(theme.ColorNameForeground), 110))
+	header.Add(createHeaderLabel("Rewards", theme.Color(theme.ColorNameForeground), 110))
+	if r.Currency != "" {
+		header.Add(createHeaderLabel(strings.ToUpper(r.Currency), theme.Color(theme.ColorNameForeground), 90))
+	}
+	if stakeColumns["balance"] {
+		if dayView {
+			header.Add(createHeaderLabel("Balance", theme.Color(theme.ColorNameForeground), 110))
+		} else {
+			header.Add(createHeaderLabel("Avg Balance", theme.Color(theme.ColorNameForeground), 110))
+		}
+	}
+	if stakeColumns["per1k"] {
+		header.Add(createHeaderLabel("Per 1k ALGO", theme.Color(theme.ColorNameForeground), 110))
+	}
+	if stakeColumns["winsPerM"] {
+		header.Add(createHeaderLabel("Wins per 1M", theme.Color(theme.ColorNameForeground), 110))
+	}
+	for _, window := range algo.MovingAverageWindows {
+		if _, ok := movingAverages[window]; ok {
+			header.Add(createHeaderLabel(fmt.Sprintf("%dd Avg (Wins)", window), theme.Color(theme.ColorNameForeground), 130))
+		}
+	}
 	headerContainer := container.New(layout.NewCustomPaddedLayout(0, 0, 10, 0), header)
 
 	content = container.NewVBox()
 	scroll := container.NewVScroll(container.New(layout.NewCustomPaddedLayout(0, 0, 5, 5), content))
-	scroll.SetMinSize(fyne.NewSize(0, 270))
+	scrollHeight := float32(270)
+	for _, obj := range createBottomBar(data[0]).Objects[1:] {
+		scrollHeight -= obj.MinSize().Height // Make room for the selected panel
+	}
+	for _, goal := range goals {
+		scrollHeight -= GoalPanel(goal).MinSize().Height + theme.Padding() // Make room for the goals
+	}
+	scroll.SetMinSize(fyne.NewSize(0, scrollHeight))
 
 	scroll.OnScrolled = func(p fyne.Position) {
 		// Load more rewards when near the bottom.
@@ -172,7 +651,11 @@ func RewardsList(account *algo.Account, r *algo.Rewards) fyne.CanvasObject {
 
 	l = newAppLayout()
 	l.mainContent = container.NewVBox(headerContainer, scroll)
-	l.bottomBar = RewardsPanel(account, r)
+	if len(columns) > 0 || r.Currency != "" {
+		// Scroll sideways to fit the optional columns
+		l.mainContent = container.NewHScroll(l.mainContent)
+	}
+	l.bottomBar = createBottomBar(data[0])
 	l.container = l.render()
 
 	loadMore() // Initial load
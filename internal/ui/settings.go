@@ -1,17 +1,24 @@
 package ui
 
 import (
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 	"github.com/calmdev/algorand-rewards/internal/algo"
 	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/price"
+	"github.com/calmdev/algorand-rewards/internal/telemetry"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
 )
 
 // SettingsForm returns the settings form.
@@ -39,6 +46,109 @@ func SettingsForm(a *app.App) fyne.CanvasObject {
 
 	// GUID setting
 	guid := createEntry("Enter your GUID", a.GUID())
+	telemetryURL := createEntry(telemetry.URLPlaceholder, a.TelemetryURL())
+
+	// createGoalEntry creates a new entry for a goal in ALGO.
+	createGoalEntry := func(placeholder string, goal float64) *widget.Entry {
+		var text string
+		if goal > 0 {
+			text = strconv.FormatFloat(goal, 'f', -1, 64)
+		}
+		entry := createEntry(placeholder, text)
+		entry.Validator = func(s string) error {
+			if s == "" {
+				return nil
+			}
+			_, err := strconv.ParseFloat(s, 64)
+			return err
+		}
+		return entry
+	}
+
+	// Reward goal settings
+	monthlyGoal := createGoalEntry("ALGO to earn each month (optional)", a.MonthlyGoal())
+	yearlyGoal := createGoalEntry("ALGO to earn by the end of the fiscal year (optional)", a.YearlyGoal())
+
+	// Price source settings
+	priceSources := []string{"", "csv", "http"}
+	priceFile := createEntry("Path to a CSV file of date,price rows", a.PriceFile())
+	priceFileBrowse := widget.NewButtonWithIcon("", theme.FolderOpenIcon(), func() {
+		dialog.ShowFileOpen(func(reader fyne.URIReadCloser, err error) {
+			if err != nil || reader == nil {
+				return
+			}
+			defer reader.Close()
+			priceFile.SetText(reader.URI().Path())
+		}, Layout.window)
+	})
+	priceFileRow := container.NewBorder(nil, nil, nil, priceFileBrowse, priceFile)
+	priceURL := createEntry(price.DefaultURL, a.PriceURL())
+	priceError := iw.NewColorLabel("", DarkRed)
+	priceError.Hide()
+	if err := price.LastError(); err != nil {
+		priceError.SetText("Prices could not be fetched: " + err.Error())
+		priceError.Show()
+	}
+	currency := createEntry(price.DefaultCurrency, a.Currency())
+
+	// Calendar settings
+	var weekdays, months []string
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		weekdays = append(weekdays, day.String())
+	}
+	for month := time.January; month <= time.December; month++ {
+		months = append(months, month.String())
+	}
+	weekStart := widget.NewSelect(weekdays, nil)
+	weekStart.SetSelectedIndex(a.WeekStart())
+	fiscalYearStart := widget.NewSelect(months, nil)
+	fiscalYearStart.SetSelectedIndex(a.FiscalYearStart() - 1)
+
+	// Tax timezone setting
+	taxTimezone := createEntry("Local timezone, or an IANA name such as America/New_York", a.TaxTimezone())
+	taxTimezone.Validator = func(s string) error {
+		_, err := time.LoadLocation(s)
+		return err
+	}
+
+	// Fleet addresses setting
+	fleetAddresses := widget.NewMultiLineEntry()
+	fleetAddresses.SetPlaceHolder("Addresses to show in the fleet overview, one per line")
+	fleetAddresses.SetText(strings.Join(a.FleetAddresses(), "\n"))
+	fleetAddresses.SetMinRowsVisible(4)
+
+	// Participation key expiry warning setting
+	keyExpiryWarnings := createEntry("Days before key expiry to warn at, such as 14,3,1", a.KeyExpiryWarnings())
+	keyExpiryWarnings.Validator = func(s string) error {
+		_, err := algo.ParseKeyExpiryWarnings(s)
+		return err
+	}
+
+	// Heartbeat alert setting
+	var heartbeatHours string
+	if hours := a.HeartbeatAlertHours(); hours > 0 {
+		heartbeatHours = strconv.FormatFloat(hours, 'f', -1, 64)
+	}
+	heartbeatAlert := createEntry("Hours without a heartbeat, empty for 3 times the usual interval", heartbeatHours)
+	heartbeatAlert.Validator = func(s string) error {
+		if s == "" {
+			return nil
+		}
+		_, err := strconv.ParseFloat(s, 64)
+		return err
+	}
+	priceSource := widget.NewSelect([]string{"None", "CSV File", "HTTP"}, func(string) {})
+	priceSource.OnChanged = func(string) {
+		priceFileRow.Hide()
+		priceURL.Hide()
+		switch priceSources[priceSource.SelectedIndex()] {
+		case "csv":
+			priceFileRow.Show()
+		case "http":
+			priceURL.Show()
+		}
+	}
+	priceSource.SetSelectedIndex(max(slices.Index(priceSources, a.PriceSource()), 0))
 
 	// Progress indicator
 	progressLabel := canvas.NewText("", theme.Color(theme.ColorNameForeground))
@@ -53,12 +163,35 @@ func SettingsForm(a *app.App) fyne.CanvasObject {
 		// Save the preferences
 		a.SetAddress(algorandWalletAddress.Text)
 		a.SetGUID(guid.Text)
+		a.SetTelemetryURL(telemetryURL.Text)
+		a.SetFleetAddresses(algo.ParseFleetAddresses(fleetAddresses.Text))
+		if monthly, err := strconv.ParseFloat(monthlyGoal.Text, 64); err == nil || monthlyGoal.Text == "" {
+			a.SetMonthlyGoal(monthly)
+		}
+		if yearly, err := strconv.ParseFloat(yearlyGoal.Text, 64); err == nil || yearlyGoal.Text == "" {
+			a.SetYearlyGoal(yearly)
+		}
+		a.SetPriceSource(priceSources[priceSource.SelectedIndex()])
+		a.SetPriceFile(priceFile.Text)
+		a.SetPriceURL(priceURL.Text)
+		a.SetCurrency(currency.Text)
+		a.SetWeekStart(weekStart.SelectedIndex())
+		a.SetFiscalYearStart(fiscalYearStart.SelectedIndex() + 1)
+		if taxTimezone.Validate() == nil {
+			a.SetTaxTimezone(taxTimezone.Text)
+		}
+		if hours, err := strconv.ParseFloat(heartbeatAlert.Text, 64); err == nil || heartbeatAlert.Text == "" {
+			a.SetHeartbeatAlertHours(hours)
+		}
+		if keyExpiryWarnings.Validate() == nil {
+			a.SetKeyExpiryWarnings(keyExpiryWarnings.Text)
+		}
 
 		// Clear the cache
-		_ = a.ClearCacheFile(
-			algo.TransactionCacheFile,
-			algo.RewardsCacheFile,
-		)
+		_ = algo.ClearCache()
+
+		// Check the warnings of the new settings
+		CheckAccountAlerts()
 
 		// Show progress indicator
 		progressLabel.Text = "Rebuilding cache..."
@@ -97,10 +230,35 @@ func SettingsForm(a *app.App) fyne.CanvasObject {
 		algorandWalletAddress,
 		createLabel("Telemetry GUID:"),
 		guid,
+		createLabel("Telemetry URL:"),
+		telemetryURL,
+		createLabel("Fleet Addresses:"),
+		fleetAddresses,
+		createLabel("Monthly Goal:"),
+		monthlyGoal,
+		createLabel("Year-End Goal:"),
+		yearlyGoal,
+		createLabel("Price Source:"),
+		priceSource,
+		priceFileRow,
+		priceURL,
+		priceError,
+		createLabel("Currency:"),
+		currency,
+		createLabel("First Day of Week:"),
+		weekStart,
+		createLabel("First Month of Fiscal Year:"),
+		fiscalYearStart,
+		createLabel("Tax Timezone:"),
+		taxTimezone,
+		createLabel("Key Expiry Warnings (days):"),
+		keyExpiryWarnings,
+		createLabel("Heartbeat Alert (hours):"),
+		heartbeatAlert,
 	)
 
 	l := newAppLayout()
-	l.mainContent = form
+	l.mainContent = container.NewVScroll(form)
 	l.bottomBar = container.NewHBox(
 		progressLabel,
 		layout.NewSpacer(),
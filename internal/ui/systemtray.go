@@ -1,13 +1,110 @@
 package ui
 
 import (
+	"maps"
+	"sync"
+	"time"
+
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"github.com/calmdev/algorand-rewards/internal/algo"
 	"github.com/calmdev/algorand-rewards/internal/app"
 )
 
+// alertCheckInterval is how often the account is checked for warnings, unless
+// its absenteeism window needs an earlier check.
+const alertCheckInterval = time.Hour
+
+// trayNoticeKinds is the order of the warnings shown at the top of the system tray menu.
+var trayNoticeKinds = []string{"absent", "stake", "heartbeat", "key"}
+
+// trayNotices holds the warnings shown at the top of the system tray menu by kind.
+var (
+	trayNotices   = map[string]string{}
+	trayNoticesMu sync.Mutex
+)
+
+// setTrayNotice sets the warning of the given kind and updates the system tray menu if it changed.
+func setTrayNotice(a *app.App, kind, message string) {
+	trayNoticesMu.Lock()
+	changed := trayNotices[kind] != message
+	trayNotices[kind] = message
+	trayNoticesMu.Unlock()
+
+	if !changed {
+		return
+	}
+	if d, ok := a.App.(desktop.App); ok && Layout != nil {
+		d.SetSystemTrayMenu(SystemTray(a, Layout.window))
+	}
+}
+
+// alertCheckNow wakes WatchAccountAlerts to check the account before the next interval.
+var alertCheckNow = make(chan struct{}, 1)
+
+// CheckAccountAlerts asks WatchAccountAlerts to check the account now.
+func CheckAccountAlerts() {
+	select {
+	case alertCheckNow <- struct{}{}:
+	default:
+	}
+}
+
+// WatchAccountAlerts checks the account for suspension risk, stake eligibility, missed heartbeats and key expiry periodically.
+//
+// The network, suspension risk and key expiry are kept for the header.
+func WatchAccountAlerts(a *app.App) {
+	for {
+		delay := alertCheckInterval
+		if address := a.Address(); address != "" {
+			algo.CurrentStakeBounds() // Resolves the network for the header
+			account := algo.FetchAccount(address)
+			absent := algo.FetchAbsenteeism(account)
+			keyExpiry := algo.FetchKeyExpiry(account)
+			if account != nil {
+				setHeaderAlerts(account, absent, keyExpiry)
+			}
+			AlertAbsenteeism(a, absent)
+			AlertEligibility(a, account, algo.FetchEligibilityCheck(account))
+			AlertHeartbeats(a, algo.FetchHeartbeatHistory(account))
+			AlertKeyExpiry(a, keyExpiry)
+			if absent != nil {
+				delay = absent.NextCheck(delay)
+			}
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-alertCheckNow:
+		}
+	}
+}
+
 // SystemTray returns the system tray menu.
 func SystemTray(a *app.App, w fyne.Window) *fyne.Menu {
-	return fyne.NewMenu(app.AppName,
+	var items []*fyne.MenuItem
+
+	// Account warnings
+	trayNoticesMu.Lock()
+	notices := maps.Clone(trayNotices)
+	trayNoticesMu.Unlock()
+	for _, kind := range trayNoticeKinds {
+		if notices[kind] == "" {
+			continue
+		}
+		notice := fyne.NewMenuItem(notices[kind], func() {
+			w.Show()
+			RenderView(&AccountView{})
+		})
+		notice.Icon = theme.WarningIcon()
+		items = append(items, notice)
+	}
+	if len(items) > 0 {
+		items = append(items, fyne.NewMenuItemSeparator())
+	}
+
+	return fyne.NewMenu(app.AppName, append(items,
 		fyne.NewMenuItem("Show", func() {
 			w.Show()
 		}),
@@ -21,5 +118,5 @@ func SystemTray(a *app.App, w fyne.Window) *fyne.Menu {
 		fyne.NewMenuItem("Quit", func() {
 			a.Quit()
 		}),
-	)
+	)...)
 }
@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// TaxList returns the tax report of a year with its monthly totals and payouts.
+func TaxList(address string, t *algo.TaxReport, years []int) fyne.CanvasObject {
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, c)
+		label.SetMinWidth(width)
+		return label
+	}
+
+	valueHeader := "Value"
+	if t.Currency != "" {
+		valueHeader = strings.ToUpper(t.Currency)
+	}
+
+	// Year selector
+	var labels []string
+	for _, year := range years {
+		labels = append(labels, fmt.Sprintf("%d", year))
+	}
+	yearSelect := widget.NewSelect(labels, nil)
+	yearSelect.SetSelected(fmt.Sprintf("%d", t.Year))
+	yearSelect.OnChanged = func(string) {
+		RenderView(&TaxView{Year: years[yearSelect.SelectedIndex()]})
+	}
+
+	// Export buttons
+	exportCSV := widget.NewButtonWithIcon("CSV", theme.DocumentSaveIcon(), func() {
+		TaxExportDialog(address, t, ".csv", Layout.window)
+	})
+	exportHTML := widget.NewButtonWithIcon("Printable", theme.DocumentPrintIcon(), func() {
+		TaxExportDialog(address, t, ".html", Layout.window)
+	})
+
+	timezone := iw.NewColorLabel("Timezone: "+t.Location.String(), Grey)
+
+	// Warn about payouts left out of the values
+	var missing fyne.CanvasObject = layout.NewSpacer()
+	if t.Missing > 0 {
+		missing = iw.NewColorLabel(fmt.Sprintf("%s payouts without a price are left out of the values", format.Int(int64(t.Missing))), Amber)
+	}
+
+	// Monthly and annual totals
+	totals := container.NewVBox(container.NewHBox(
+		createHeaderLabel("Month", 120),
+		createHeaderLabel("Payouts", 80),
+		createHeaderLabel("Amount", 140),
+		createHeaderLabel(valueHeader, 110),
+	))
+	for _, month := range t.Months {
+		totals.Add(widget.NewSeparator())
+		totals.Add(container.NewHBox(
+			createCellLabel(month.Month, Grey, 120),
+			createCellLabel(format.Int(int64(month.Count)), theme.Color(theme.ColorNameForeground), 80),
+			createCellLabel(format.Float(month.Amount), theme.Color(theme.ColorNameForeground), 140),
+			createCellLabel(format.Fiat(month.Value), theme.Color(theme.ColorNameForeground), 110),
+		))
+	}
+	totals.Add(widget.NewSeparator())
+	totals.Add(container.NewHBox(
+		createHeaderLabel(fmt.Sprintf("%d", t.Year), 120),
+		createHeaderLabel(format.Int(int64(len(t.Entries))), 80),
+		createHeaderLabel(format.Float(t.Amount), 140),
+		createHeaderLabel(format.Fiat(t.Value), 110),
+	))
+
+	// Every payout of the year
+	entries := widget.NewList(
+		func() int {
+			return len(t.Entries)
+		},
+		func() fyne.CanvasObject {
+			return container.NewHBox(
+				createCellLabel("", Grey, 170),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 110),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 110),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			entry := t.Entries[id]
+			cells := obj.(*fyne.Container).Objects
+			cells[0].(*iw.ColorLabel).SetText(entry.Time.Format("2006-01-02 15:04:05"))
+			cells[1].(*iw.ColorLabel).SetText(fmt.Sprintf("%d", entry.Round))
+			cells[2].(*iw.ColorLabel).SetText(format.Float(entry.Amount))
+			if entry.PriceMissing {
+				cells[3].(*iw.ColorLabel).SetText("Missing")
+				cells[4].(*iw.ColorLabel).SetText("-")
+			} else {
+				cells[3].(*iw.ColorLabel).SetText(fmt.Sprintf("%.4f", entry.Price))
+				cells[4].(*iw.ColorLabel).SetText(format.Fiat(entry.Value))
+			}
+		},
+	)
+	entriesHeader := container.NewHBox(
+		createHeaderLabel("Received", 170),
+		createHeaderLabel("Round", 110),
+		createHeaderLabel("Amount", 110),
+		createHeaderLabel("Price", 90),
+		createHeaderLabel(valueHeader, 90),
+	)
+
+	tabs := container.NewAppTabs(
+		container.NewTabItem("Monthly Totals", container.NewVScroll(totals)),
+		container.NewTabItem("Payouts", container.NewBorder(entriesHeader, nil, nil, nil, entries)),
+	)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewBorder(
+		container.NewBorder(nil, nil, nil, container.NewHBox(timezone, exportCSV, exportHTML), yearSelect),
+		missing,
+		nil,
+		nil,
+		tabs,
+	))
+}
+
+// TaxExportDialog opens a dialog to export a tax report as CSV (.csv) or printable HTML (.html).
+func TaxExportDialog(address string, t *algo.TaxReport, ext string, w fyne.Window) {
+	d := dialog.NewFileSave(
+		func(writer fyne.URIWriteCloser, err error) {
+			if err != nil {
+				return
+			}
+			if writer == nil {
+				return
+			}
+			defer writer.Close()
+			if ext == ".html" {
+				algo.ExportTaxReportHTML(t, address, writer)
+			} else {
+				algo.ExportTaxReport(t, writer)
+			}
+		},
+		w,
+	)
+	d.SetFileName(fmt.Sprintf("rewards-%d%s", t.Year, ext))
+	d.SetFilter(storage.NewExtensionFileFilter([]string{ext}))
+	d.SetView(dialog.ListView)
+	d.Resize(fyne.NewSize(MainWindowWidth-20, MainWindowHeight-20))
+	d.Show()
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"net/url"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	"github.com/calmdev/algorand-rewards/internal/telemetry"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// TelemetryList returns the sync status, peers, version, uptime and recent errors of the node.
+func TelemetryList(a *app.App, node *telemetry.Node, err error) fyne.CanvasObject {
+	// createTitle creates a new title for a section.
+	createTitle := func(title string) fyne.CanvasObject {
+		text := canvas.NewText(title, Grey)
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+		return container.NewVBox(text, widget.NewSeparator())
+	}
+
+	// createRow creates a new row with a label and a value.
+	createRow := func(label, value string, c color.Color) *fyne.Container {
+		text := iw.NewColorLabel(label, theme.Color(theme.ColorNameForeground))
+		text.SetMinWidth(160)
+		text.SetTextStyle(fyne.TextStyle{Bold: true})
+		return container.NewHBox(text, iw.NewColorLabel(value, c))
+	}
+
+	// Dashboard button
+	dashboard := widget.NewButtonWithIcon("Open Dashboard", theme.ComputerIcon(), func() {
+		url := url.URL{
+			Scheme:   "https",
+			Host:     "g.nodely.io",
+			Path:     "/d/telemetry/node-telemetry",
+			RawQuery: fmt.Sprintf("var-GUID=%s&orgId=1&from=now-24h&to=now", a.GUID()),
+		}
+		if err := a.OpenURL(&url); err != nil {
+			dialog.ShowError(err, Layout.window)
+		}
+	})
+
+	if err != nil {
+		message := "Telemetry is unavailable: " + err.Error()
+		switch err {
+		case telemetry.ErrNoGUID:
+			message = "Please set your GUID in the settings to enable telemetry."
+		case telemetry.ErrNoURL:
+			message = "Please set the telemetry URL in the settings to show telemetry in the app."
+		}
+		return container.NewCenter(container.NewVBox(widget.NewLabel(message), container.NewCenter(dashboard)))
+	}
+
+	foreground := theme.Color(theme.ColorNameForeground)
+
+	// Sync status
+	syncStatus, syncColor := "Synced", DarkGreen
+	if !node.Synced {
+		syncStatus, syncColor = "Catching up", Amber
+	}
+	status := container.NewVBox(
+		createTitle("Node"),
+		createRow("Name:", node.Name, foreground),
+		createRow("Sync Status:", syncStatus, syncColor),
+		createRow("Round:", format.Int(node.Round), foreground),
+		createRow("Version:", node.Version, foreground),
+		createRow("Uptime:", format.Duration(node.UptimeDuration()), foreground),
+		createRow("Last Seen:", node.LastSeenTime().Format("2006-01-02 15:04:05"), Grey),
+	)
+
+	// Peers
+	peersColor := DarkGreen
+	if node.Peers.Total() == 0 {
+		peersColor = DarkRed
+	}
+	peers := container.NewVBox(
+		createTitle("Peers"),
+		createRow("Total:", fmt.Sprintf("%d", node.Peers.Total()), peersColor),
+		createRow("Incoming:", fmt.Sprintf("%d", node.Peers.Incoming), foreground),
+		createRow("Outgoing:", fmt.Sprintf("%d", node.Peers.Outgoing), foreground),
+	)
+
+	// Recent errors
+	errors := container.NewVBox(createTitle(fmt.Sprintf("Recent Errors (%d)", len(node.Errors))))
+	if len(node.Errors) == 0 {
+		errors.Add(iw.NewColorLabel("No recent errors.", Grey))
+	}
+	for _, event := range node.Errors {
+		errors.Add(container.NewHBox(
+			iw.NewColorLabel(event.Time().Format("2006-01-02 15:04"), Grey),
+			iw.NewColorLabel(strings.ToUpper(event.Severity), DarkRed),
+			iw.NewColorLabel(event.Message, foreground),
+		))
+	}
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewBorder(
+		container.NewVBox(
+			container.NewHBox(layout.NewSpacer(), dashboard),
+			container.NewGridWithColumns(2, status, peers),
+		),
+		nil,
+		nil,
+		nil,
+		container.NewVScroll(errors),
+	))
+}
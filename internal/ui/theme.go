@@ -20,6 +20,7 @@ var (
 	DarkGrey    = color.RGBA{23, 23, 24, 255}
 	DarkGreen   = color.RGBA{0, 128, 0, 255}
 	DarkRed     = color.RGBA{234, 47, 73, 255}
+	Amber       = color.RGBA{255, 176, 32, 255}
 )
 
 // AppTheme is a custom theme for the application.
@@ -103,7 +104,7 @@ func WatchForThemeVariantChanges(a *app.App, w fyne.Window, themeVariant *fyne.T
 		if a.Settings().ThemeVariant() != *themeVariant {
 			*themeVariant = a.Settings().ThemeVariant()
 			currentView := Layout.currentView
-			w.SetContent(RenderLayout())
+			w.SetContent(RenderLayout(w))
 			RenderView(currentView)
 		}
 
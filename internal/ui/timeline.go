@@ -0,0 +1,262 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/calmdev/algorand-rewards/internal/algo"
+	"github.com/calmdev/algorand-rewards/internal/format"
+	iw "github.com/calmdev/algorand-rewards/internal/ui/widget"
+)
+
+// timelineMaxDays is the most days shown in the timeline chart.
+const timelineMaxDays = 365
+
+// TimelineList returns the online and offline history overlaid on the daily rewards and the key registrations.
+func TimelineList(t *algo.Timeline, r *algo.Rewards) fyne.CanvasObject {
+	// createText creates a new text for the timeline summary.
+	createText := func(label, value string, c color.Color) *fyne.Container {
+		text := canvas.NewText(label, theme.Color(theme.ColorNameForeground))
+		text.TextStyle.Bold = true
+		text.TextSize = 12
+
+		valueText := canvas.NewText(value, c)
+		valueText.TextSize = 12
+		valueText.TextStyle.Bold = true
+
+		return container.NewHBox(text, layout.NewSpacer(), valueText)
+	}
+
+	// createTitle creates a new title for a chart.
+	createTitle := func(title string) *canvas.Text {
+		text := canvas.NewText(title, Grey)
+		text.TextStyle.Bold = true
+		text.TextSize = 11
+		return text
+	}
+
+	// createLegend creates a new legend entry with a color swatch.
+	createLegend := func(label string, c color.Color) *fyne.Container {
+		swatch := canvas.NewRectangle(c)
+		swatch.SetMinSize(fyne.NewSize(10, 10))
+		text := canvas.NewText(label, Grey)
+		text.TextSize = 10
+		return container.NewHBox(container.NewCenter(swatch), text)
+	}
+
+	// createHeaderLabel creates a new header label.
+	createHeaderLabel := func(text string, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, theme.Color(theme.ColorNameForeground))
+		label.SetMinWidth(width)
+		label.SetTextStyle(fyne.TextStyle{Bold: true})
+		return label
+	}
+
+	// createCellLabel creates a new cell label.
+	createCellLabel := func(text string, c color.Color, width float32) *iw.ColorLabel {
+		label := iw.NewColorLabel(text, c)
+		label.SetMinWidth(width)
+		return label
+	}
+
+	// fade returns the color with the given alpha.
+	fade := func(c color.Color, alpha uint8) color.Color {
+		r, g, b, _ := c.RGBA()
+		return color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: alpha}
+	}
+
+	if t == nil || len(t.Events) == 0 {
+		return container.NewCenter(widget.NewLabel("No key registrations found for this account."))
+	}
+
+	offlineColor := fade(DarkRed, 60)
+	ineligibleColor := fade(Amber, 60)
+
+	// Days of the chart from the first registration or payout, limited to the selected range
+	today := time.Date(t.Now.Year(), t.Now.Month(), t.Now.Day(), 0, 0, 0, 0, time.Local)
+	first := t.Events[0].Time.In(time.Local)
+	if len(r.Daily) > 0 {
+		if day, err := time.ParseInLocation("2006-01-02", r.Daily[0].Date, time.Local); err == nil && day.Before(first) {
+			first = day
+		}
+	}
+	start := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.Local)
+	if earliest := today.AddDate(0, 0, -timelineMaxDays+1); start.Before(earliest) {
+		start = earliest
+	}
+	if rangeStart, err := time.ParseInLocation("2006-01-02", r.Start, time.Local); err == nil && rangeStart.After(start) {
+		start = rangeStart
+	}
+	days := int(today.Sub(start).Hours()/24+0.5) + 1
+
+	// dayIndex returns the bar of the day the time falls on, or -1 if outside the chart.
+	dayIndex := func(at time.Time) int {
+		at = at.In(time.Local)
+		day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.Local)
+		index := int(day.Sub(start).Hours()/24 + 0.5)
+		if index < 0 || index >= days {
+			return -1
+		}
+		return index
+	}
+
+	// Daily rewards
+	payouts := make(map[string]float64, len(r.Daily))
+	for _, payout := range r.Daily {
+		payouts[payout.Date] = payout.AlgoPayout()
+	}
+	values := make([]float64, days)
+	labels := make([]string, days)
+	for i := range days {
+		day := start.AddDate(0, 0, i)
+		values[i] = payouts[day.Format("2006-01-02")]
+		if day.Day() == 1 {
+			labels[i] = day.Format("Jan")
+		}
+	}
+
+	// Shade the days the account was offline or online without the eligibility fee
+	var bands []iw.ChartBand
+	for i := range days {
+		period := t.PeriodAt(start.AddDate(0, 0, i).Add(12 * time.Hour))
+		var c color.Color
+		switch {
+		case period == nil:
+			continue
+		case !period.Online:
+			c = offlineColor
+		case !period.Eligible:
+			c = ineligibleColor
+		default:
+			continue
+		}
+		if n := len(bands); n > 0 && bands[n-1].End == i-1 && bands[n-1].Color == c {
+			bands[n-1].End = i
+			continue
+		}
+		bands = append(bands, iw.ChartBand{Start: i, End: i, Color: c})
+	}
+
+	// Mark the registrations and the key validity windows
+	var markers []iw.ChartMarker
+	for _, event := range t.Events {
+		c := DarkRed
+		if event.Online {
+			c = DarkGreen
+		}
+		if index := dayIndex(event.Time); index >= 0 {
+			markers = append(markers, iw.ChartMarker{Index: index, Color: c})
+		}
+		if !event.Online {
+			continue
+		}
+		for _, at := range []time.Time{event.ValidFrom, event.ValidUntil} {
+			if index := dayIndex(at); index >= 0 {
+				markers = append(markers, iw.ChartMarker{Index: index, Color: Grey})
+			}
+		}
+	}
+
+	chart := iw.NewBarChart(values, labels, theme.Color(theme.ColorNamePrimary), 80)
+	chart.SetLabelColor(Grey)
+	chart.SetOverlay(bands, markers)
+
+	legend := container.NewHBox(
+		createLegend("Offline", offlineColor),
+		createLegend("Online, not eligible", ineligibleColor),
+		createLegend("Went online", DarkGreen),
+		createLegend("Went offline", DarkRed),
+		createLegend("Key valid from / until", Grey),
+	)
+
+	// Summary
+	status, statusColor := "Offline", DarkRed
+	if current := t.PeriodAt(t.Now.Add(-time.Second)); current != nil && current.Online {
+		status, statusColor = "Online", DarkGreen
+		if !current.Eligible {
+			statusColor = Amber
+		}
+	}
+	summary := container.NewHBox(
+		createText("Registrations: ", format.Int(int64(len(t.Events))), theme.Color(theme.ColorNameForeground)),
+		layout.NewSpacer(),
+		createText("Eligibility Fees Paid: ", format.Int(int64(t.EligibleRegistrations())), theme.Color(theme.ColorNameForeground)),
+		layout.NewSpacer(),
+		createText("Time Online: ", format.Duration(t.OnlineTime()), theme.Color(theme.ColorNameForeground)),
+		layout.NewSpacer(),
+		createText("Status: ", status, statusColor),
+	)
+
+	// Key registrations, newest first
+	header := container.NewHBox(
+		createHeaderLabel("Time", 130),
+		createHeaderLabel("Round", 90),
+		createHeaderLabel("Event", 70),
+		createHeaderLabel("Fee", 80),
+		createHeaderLabel("Eligible", 60),
+		createHeaderLabel("Valid Rounds", 160),
+		createHeaderLabel("Valid Until", 90),
+	)
+	list := widget.NewList(
+		func() int {
+			return len(t.Events)
+		},
+		func() fyne.CanvasObject {
+			return container.NewHBox(
+				createCellLabel("", Grey, 130),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 90),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 70),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 80),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 60),
+				createCellLabel("", theme.Color(theme.ColorNameForeground), 160),
+				createCellLabel("", Grey, 90),
+			)
+		},
+		func(id widget.ListItemID, obj fyne.CanvasObject) {
+			event := t.Events[len(t.Events)-1-id]
+			cells := obj.(*fyne.Container).Objects
+			cells[0].(*iw.ColorLabel).SetText(event.Time.Format("2006-01-02 15:04"))
+			cells[1].(*iw.ColorLabel).SetText(fmt.Sprintf("%d", event.Round))
+			if event.Online {
+				cells[2].(*iw.ColorLabel).SetText("Online")
+				cells[2].(*iw.ColorLabel).SetColor(DarkGreen)
+				cells[5].(*iw.ColorLabel).SetText(fmt.Sprintf("%d - %d", event.FirstValid, event.LastValid))
+				cells[6].(*iw.ColorLabel).SetText(event.ValidUntil.Format("2006-01-02"))
+			} else {
+				cells[2].(*iw.ColorLabel).SetText("Offline")
+				cells[2].(*iw.ColorLabel).SetColor(DarkRed)
+				cells[5].(*iw.ColorLabel).SetText("-")
+				cells[6].(*iw.ColorLabel).SetText("-")
+			}
+			cells[3].(*iw.ColorLabel).SetText(format.Float(event.AlgoFee()))
+			if event.Eligible {
+				cells[4].(*iw.ColorLabel).SetText("Yes")
+				cells[4].(*iw.ColorLabel).SetColor(DarkGreen)
+			} else {
+				cells[4].(*iw.ColorLabel).SetText("No")
+				cells[4].(*iw.ColorLabel).SetColor(Grey)
+			}
+		},
+	)
+
+	return container.New(layout.NewCustomPaddedLayout(0, 5, 5, 5), container.NewBorder(
+		container.NewVBox(
+			summary,
+			createTitle("Daily Rewards and Online Status"),
+			chart,
+			legend,
+			header,
+		),
+		nil,
+		nil,
+		nil,
+		list,
+	))
+}
@@ -1,8 +1,12 @@
 package ui
 
 import (
+	"slices"
+	"time"
+
 	"github.com/calmdev/algorand-rewards/internal/algo"
 	"github.com/calmdev/algorand-rewards/internal/app"
+	"github.com/calmdev/algorand-rewards/internal/telemetry"
 )
 
 const (
@@ -21,6 +25,9 @@ type View interface {
 	Render(a *app.App)
 }
 
+// rewardsUnavailable is the message shown when the rewards could not be fetched.
+const rewardsUnavailable = "Rewards are unavailable. Check your connection and refresh."
+
 // RewardsView struct represents the rewards view.
 type RewardsView struct{}
 
@@ -31,6 +38,15 @@ func (v *RewardsView) Render(a *app.App) {
 	go func() {
 		account := algo.FetchAccount(a.Address())
 		rewards := algo.FetchRewards(a.Address())
+		if rewards == nil {
+			Layout.updateTopBar(Header(account))
+			Layout.unavailable(rewardsUnavailable)
+			Layout.currentView = v
+			return
+		}
+
+		rewards.Luck = algo.FetchLuck(account)
+		rewards.SetStake(algo.FetchBalanceHistory(account, rewards.Blocks))
 
 		Layout.updateTopBar(Header(account))
 		Layout.updateMainContent(RewardsList(account, rewards))
@@ -54,7 +70,7 @@ func (v *SettingsView) Render(a *app.App) {
 	}()
 
 	Layout.updateMainContent(SettingsForm(a))
-	Layout.markActiveButton(2)
+	Layout.markActiveButton(5)
 	Layout.currentView = v
 }
 
@@ -76,3 +92,308 @@ func (v *TransactionsView) Render(a *app.App) {
 
 	Layout.markActiveButton(1)
 }
+
+// ForecastView struct represents the forecast view.
+type ForecastView struct{}
+
+// Render renders the forecast view.
+func (v *ForecastView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		var forecast *algo.Forecast
+		if rewards := algo.FetchRewards(a.Address()); rewards != nil {
+			forecast = algo.NewForecast(rewards, account, algo.FetchLuck(account), algo.MainnetBonusPlan)
+		}
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(ForecastList(forecast))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(2)
+}
+
+// BlocksView struct represents the blocks proposed in a period of the rewards view.
+type BlocksView struct {
+	Period string
+}
+
+// Render renders the blocks view.
+func (v *BlocksView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		rewards := algo.FetchRewards(a.Address())
+		if rewards == nil {
+			Layout.updateTopBar(Header(account))
+			Layout.unavailable(rewardsUnavailable)
+			Layout.currentView = v
+			return
+		}
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(BlocksList(v.Period, rewards.BlocksIn(v.Period)))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(0)
+}
+
+// ComparisonView struct represents the period comparison mode of the rewards view.
+type ComparisonView struct{}
+
+// Render renders the comparison view.
+func (v *ComparisonView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		rewards := algo.FetchRewards(a.Address())
+		if rewards == nil {
+			Layout.updateTopBar(Header(account))
+			Layout.unavailable(rewardsUnavailable)
+			Layout.currentView = v
+			return
+		}
+
+		rewards.SetStake(algo.FetchBalanceHistory(account, rewards.Blocks))
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(ComparisonList(rewards))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(0)
+}
+
+// BreakdownView struct represents the bonus and fee breakdown of the rewards view.
+type BreakdownView struct{}
+
+// Render renders the breakdown view.
+func (v *BreakdownView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		rewards := algo.FetchRewards(a.Address())
+		if rewards == nil {
+			Layout.updateTopBar(Header(account))
+			Layout.unavailable(rewardsUnavailable)
+			Layout.currentView = v
+			return
+		}
+
+		breakdown := algo.NewBreakdown(rewards, algo.MainnetBonusPlan)
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(BreakdownList(breakdown, algo.FetchLuck(account)))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(0)
+}
+
+// TaxView struct represents the tax report view.
+type TaxView struct {
+	Year int
+}
+
+// Render renders the tax report view.
+func (v *TaxView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		rewards := algo.FetchRewards(a.Address())
+		if rewards == nil {
+			Layout.updateTopBar(Header(account))
+			Layout.unavailable(rewardsUnavailable)
+			Layout.currentView = v
+			return
+		}
+		years := algo.TaxYears(rewards.Blocks, algo.TaxLocation())
+		if v.Year == 0 {
+			v.Year = time.Now().Year()
+			if len(years) > 0 {
+				v.Year = years[0]
+			}
+		}
+		if !slices.Contains(years, v.Year) {
+			years = append([]int{v.Year}, years...)
+		}
+		report := algo.FetchTaxReport(rewards, v.Year)
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(TaxList(a.Address(), report, years))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(0)
+}
+
+// LotsView struct represents the cost basis lots view.
+type LotsView struct{}
+
+// Render renders the cost basis lots view.
+func (v *LotsView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		report := algo.FetchLotReport(a.Address())
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(LotList(report))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(1)
+}
+
+// HourlyView struct represents the hourly distribution of the rewards view.
+type HourlyView struct{}
+
+// Render renders the hourly distribution view.
+func (v *HourlyView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		rewards := algo.FetchRewards(a.Address())
+		if rewards == nil {
+			Layout.updateTopBar(Header(account))
+			Layout.unavailable(rewardsUnavailable)
+			Layout.currentView = v
+			return
+		}
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(HourlyList(algo.NewHourlyDistribution(rewards)))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(0)
+}
+
+// AccountView struct represents the account view.
+type AccountView struct{}
+
+// Render renders the account view.
+func (v *AccountView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(AccountDetails(account, algo.FetchAbsenteeism(account), algo.FetchEligibilityCheck(account)))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(3)
+}
+
+// HeartbeatView struct represents the heartbeat view.
+type HeartbeatView struct{}
+
+// Render renders the heartbeat view.
+func (v *HeartbeatView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		heartbeats := algo.FetchHeartbeatHistory(account)
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(HeartbeatList(heartbeats))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(3)
+}
+
+// TimelineView struct represents the online and offline timeline view.
+type TimelineView struct{}
+
+// Render renders the timeline view.
+func (v *TimelineView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		rewards := algo.FetchRewards(a.Address())
+		if rewards == nil {
+			Layout.updateTopBar(Header(account))
+			Layout.unavailable(rewardsUnavailable)
+			Layout.currentView = v
+			return
+		}
+
+		timeline := algo.FetchTimeline(a.Address())
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(TimelineList(timeline, rewards))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(3)
+}
+
+// FleetView struct represents the fleet overview.
+type FleetView struct{}
+
+// Render renders the fleet overview.
+func (v *FleetView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		fleet := algo.FetchFleet(a.FleetAddresses())
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(FleetList(a, fleet))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(3)
+}
+
+// HealthView struct represents the node health view.
+type HealthView struct{}
+
+// Render renders the node health view.
+func (v *HealthView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(HealthList(algo.FetchHealth(account)))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(4)
+}
+
+// TelemetryView struct represents the node telemetry view.
+type TelemetryView struct{}
+
+// Render renders the node telemetry view.
+func (v *TelemetryView) Render(a *app.App) {
+	Layout.loading()
+
+	go func() {
+		account := algo.FetchAccount(a.Address())
+		node, err := telemetry.FetchNode(telemetry.CurrentClient())
+
+		Layout.updateTopBar(Header(account))
+		Layout.updateMainContent(TelemetryList(a, node, err))
+		Layout.currentView = v
+	}()
+
+	Layout.markActiveButton(4)
+}
@@ -0,0 +1,349 @@
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/widget"
+)
+
+var _ fyne.Widget = (*BarChart)(nil)
+
+// BarChart is a widget that draws a series of values as vertical bars.
+type BarChart struct {
+	widget.BaseWidget
+	values     []float64
+	labels     []string
+	color      color.Color
+	labelColor color.Color
+	height     float32
+	bands      []ChartBand
+	markers    []ChartMarker
+}
+
+// ChartBand shades the bars from Start to End inclusive behind the chart.
+type ChartBand struct {
+	Start int
+	End   int
+	Color color.Color
+}
+
+// ChartMarker draws a vertical line in the middle of the bar at Index over the chart.
+type ChartMarker struct {
+	Index int
+	Color color.Color
+}
+
+// NewBarChart returns a bar chart of the given values.
+func NewBarChart(values []float64, labels []string, c color.Color, height float32) *BarChart {
+	chart := &BarChart{
+		values: values,
+		labels: labels,
+		color:  c,
+		height: height,
+	}
+	chart.ExtendBaseWidget(chart)
+	return chart
+}
+
+// SetLabelColor sets the color of the labels.
+func (c *BarChart) SetLabelColor(lc color.Color) {
+	c.labelColor = lc
+	c.Refresh()
+}
+
+// SetOverlay sets the bands shaded behind the bars and the markers drawn over them.
+func (c *BarChart) SetOverlay(bands []ChartBand, markers []ChartMarker) {
+	c.bands = bands
+	c.markers = markers
+	c.Refresh()
+}
+
+func (c *BarChart) CreateRenderer() fyne.WidgetRenderer {
+	r := &barChartRenderer{chart: c}
+	r.createOverlay()
+	for range c.values {
+		r.bars = append(r.bars, canvas.NewRectangle(c.color))
+	}
+	for _, label := range c.labels {
+		text := canvas.NewText(label, c.labelColor)
+		text.TextSize = 8
+		text.Alignment = fyne.TextAlignCenter
+		r.labels = append(r.labels, text)
+	}
+	return r
+}
+
+type barChartRenderer struct {
+	chart   *BarChart
+	bars    []*canvas.Rectangle
+	labels  []*canvas.Text
+	bands   []*canvas.Rectangle
+	markers []*canvas.Line
+}
+
+// createOverlay creates the bands and markers of the chart.
+func (r *barChartRenderer) createOverlay() {
+	r.bands = r.bands[:0]
+	for _, band := range r.chart.bands {
+		r.bands = append(r.bands, canvas.NewRectangle(band.Color))
+	}
+	r.markers = r.markers[:0]
+	for _, marker := range r.chart.markers {
+		line := canvas.NewLine(marker.Color)
+		line.StrokeWidth = 1
+		r.markers = append(r.markers, line)
+	}
+}
+
+func (r *barChartRenderer) Layout(size fyne.Size) {
+	if len(r.bars) == 0 {
+		return
+	}
+
+	var maxValue float64
+	for _, v := range r.chart.values {
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+
+	var labelHeight float32
+	if len(r.labels) > 0 {
+		labelHeight = r.labels[0].MinSize().Height
+	}
+
+	chartHeight := size.Height - labelHeight
+	slot := size.Width / float32(len(r.bars))
+	gap := slot * 0.2
+
+	for i, bar := range r.bars {
+		var height float32
+		if maxValue > 0 {
+			height = chartHeight * float32(r.chart.values[i]/maxValue)
+		}
+		bar.Move(fyne.NewPos(float32(i)*slot+gap/2, chartHeight-height))
+		bar.Resize(fyne.NewSize(slot-gap, height))
+	}
+
+	for i, label := range r.labels {
+		label.Move(fyne.NewPos(float32(i)*slot, chartHeight))
+		label.Resize(fyne.NewSize(slot, labelHeight))
+	}
+
+	for i, rect := range r.bands {
+		band := r.chart.bands[i]
+		rect.Move(fyne.NewPos(float32(band.Start)*slot, 0))
+		rect.Resize(fyne.NewSize(float32(band.End-band.Start+1)*slot, chartHeight))
+	}
+
+	for i, line := range r.markers {
+		x := (float32(r.chart.markers[i].Index) + 0.5) * slot
+		line.Position1 = fyne.NewPos(x, 0)
+		line.Position2 = fyne.NewPos(x, chartHeight)
+	}
+}
+
+func (r *barChartRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(float32(len(r.bars))*4, r.chart.height)
+}
+
+func (r *barChartRenderer) Refresh() {
+	if len(r.bands) != len(r.chart.bands) || len(r.markers) != len(r.chart.markers) {
+		r.createOverlay()
+		r.Layout(r.chart.Size())
+	}
+	for i, band := range r.bands {
+		band.FillColor = r.chart.bands[i].Color
+		band.Refresh()
+	}
+	for i, marker := range r.markers {
+		marker.StrokeColor = r.chart.markers[i].Color
+		marker.Refresh()
+	}
+	for _, bar := range r.bars {
+		bar.FillColor = r.chart.color
+		bar.Refresh()
+	}
+	for _, label := range r.labels {
+		label.Color = r.chart.labelColor
+		label.Refresh()
+	}
+}
+
+func (r *barChartRenderer) BackgroundColor() color.Color {
+	return color.Transparent
+}
+
+func (r *barChartRenderer) Objects() []fyne.CanvasObject {
+	objects := make([]fyne.CanvasObject, 0, len(r.bands)+len(r.bars)+len(r.markers)+len(r.labels))
+	for _, band := range r.bands {
+		objects = append(objects, band)
+	}
+	for _, bar := range r.bars {
+		objects = append(objects, bar)
+	}
+	for _, marker := range r.markers {
+		objects = append(objects, marker)
+	}
+	for _, label := range r.labels {
+		objects = append(objects, label)
+	}
+	return objects
+}
+
+func (r *barChartRenderer) Destroy() {}
+
+var _ fyne.Widget = (*LineChart)(nil)
+
+// LineChart is a widget that draws one or more series of values as lines.
+//
+// All series share the same scale and are drawn from left to right.
+type LineChart struct {
+	widget.BaseWidget
+	series     [][]float64
+	colors     []color.Color
+	labels     []string
+	labelColor color.Color
+	height     float32
+}
+
+// NewLineChart returns a line chart of the given series.
+func NewLineChart(series [][]float64, colors []color.Color, height float32) *LineChart {
+	chart := &LineChart{
+		series: series,
+		colors: colors,
+		height: height,
+	}
+	chart.ExtendBaseWidget(chart)
+	return chart
+}
+
+// SetLabels sets the labels along the bottom of the chart and their color.
+func (c *LineChart) SetLabels(labels []string, lc color.Color) {
+	c.labels = labels
+	c.labelColor = lc
+	c.Refresh()
+}
+
+// bounds returns the minimum and maximum of all series.
+func (c *LineChart) bounds() (float64, float64) {
+	var lo, hi float64
+	first := true
+	for _, values := range c.series {
+		for _, v := range values {
+			if first || v < lo {
+				lo = v
+			}
+			if first || v > hi {
+				hi = v
+			}
+			first = false
+		}
+	}
+	return lo, hi
+}
+
+func (c *LineChart) CreateRenderer() fyne.WidgetRenderer {
+	r := &lineChartRenderer{chart: c}
+	for i, values := range c.series {
+		var lines []*canvas.Line
+		for j := 1; j < len(values); j++ {
+			line := canvas.NewLine(c.colors[i])
+			line.StrokeWidth = 1.5
+			lines = append(lines, line)
+		}
+		r.lines = append(r.lines, lines)
+	}
+	for _, label := range c.labels {
+		text := canvas.NewText(label, c.labelColor)
+		text.TextSize = 8
+		r.labels = append(r.labels, text)
+	}
+	return r
+}
+
+type lineChartRenderer struct {
+	chart  *LineChart
+	lines  [][]*canvas.Line
+	labels []*canvas.Text
+}
+
+func (r *lineChartRenderer) Layout(size fyne.Size) {
+	lo, hi := r.chart.bounds()
+	if hi == lo {
+		lo, hi = lo-1, hi+1
+	}
+
+	var labelHeight float32
+	if len(r.labels) > 0 {
+		labelHeight = r.labels[0].MinSize().Height
+	}
+	chartHeight := size.Height - labelHeight
+
+	// point returns the position of the j-th value of a series with n values.
+	point := func(j, n int, v float64) fyne.Position {
+		x := float32(0)
+		if n > 1 {
+			x = size.Width * float32(j) / float32(n-1)
+		}
+		y := chartHeight - chartHeight*float32((v-lo)/(hi-lo))
+		return fyne.NewPos(x, y)
+	}
+
+	for i, lines := range r.lines {
+		values := r.chart.series[i]
+		for j, line := range lines {
+			line.Position1 = point(j, len(values), values[j])
+			line.Position2 = point(j+1, len(values), values[j+1])
+		}
+	}
+
+	// Spread the labels evenly along the bottom
+	for i, label := range r.labels {
+		width := label.MinSize().Width
+		x := float32(0)
+		if len(r.labels) > 1 {
+			x = (size.Width - width) * float32(i) / float32(len(r.labels)-1)
+		}
+		label.Move(fyne.NewPos(x, chartHeight))
+		label.Resize(fyne.NewSize(width, labelHeight))
+	}
+}
+
+func (r *lineChartRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(50, r.chart.height)
+}
+
+func (r *lineChartRenderer) Refresh() {
+	for i, lines := range r.lines {
+		for _, line := range lines {
+			line.StrokeColor = r.chart.colors[i]
+			line.Refresh()
+		}
+	}
+	for _, label := range r.labels {
+		label.Color = r.chart.labelColor
+		label.Refresh()
+	}
+}
+
+func (r *lineChartRenderer) BackgroundColor() color.Color {
+	return color.Transparent
+}
+
+func (r *lineChartRenderer) Objects() []fyne.CanvasObject {
+	var objects []fyne.CanvasObject
+	for _, lines := range r.lines {
+		for _, line := range lines {
+			objects = append(objects, line)
+		}
+	}
+	for _, label := range r.labels {
+		objects = append(objects, label)
+	}
+	return objects
+}
+
+func (r *lineChartRenderer) Destroy() {}
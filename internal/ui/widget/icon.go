@@ -0,0 +1,30 @@
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// TappableIcon is an icon that calls a function when tapped.
+type TappableIcon struct {
+	widget.Icon
+	tapped func()
+}
+
+func NewTappableIcon(res fyne.Resource, tapped func()) *TappableIcon {
+	icon := &TappableIcon{tapped: tapped}
+	icon.ExtendBaseWidget(icon)
+	icon.SetResource(res)
+	return icon
+}
+
+func (i *TappableIcon) Cursor() desktop.Cursor {
+	return desktop.PointerCursor
+}
+
+func (i *TappableIcon) Tapped(_ *fyne.PointEvent) {
+	if i.tapped != nil {
+		i.tapped()
+	}
+}